@@ -9,77 +9,143 @@ import (
 	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Delays between attempts to reconnect to the server
+const (
+	initialBackoff = time.Second
+	maxBackoff     = 30 * time.Second
 )
 
 type Client struct {
-	conn     *grpc.ClientConn
-	client   pb.ExperimentServiceClient
-	stream   pb.ExperimentService_ConnectClient
-	username string
-	start    chan struct{}
-	msg      chan string
+	conn         *grpc.ClientConn
+	client       pb.ExperimentServiceClient
+	ctx          context.Context
+	cancel       context.CancelFunc
+	mu           sync.Mutex // Guards stream and sessionToken, which change on reconnect, and waiting
+	stream       pb.ExperimentService_ConnectClient
+	username     string
+	experimentID string
+	sessionToken string // Token to resume the session, received after joining the experiment
+	min, max     int    // Range of the number to guess, received when the experiment starts
+	maxGuesses   int    // Number of guesses allowed, no limit if 0
+	start        chan *pb.ExperimentStarted
+	msg          chan *pb.ServerMessage // Response to the last guess
+	waiting      bool                   // Set while the user waits for a response
+	over         chan struct{}          // Closed when the experiment is over for the client
+	overOnce     sync.Once
 }
 
 // NewClient initializes the client and establishes a connection with the server
-func NewClient(serverAddr, username string) (*Client, error) {
+func NewClient(serverAddr, username, experimentID string) (*Client, error) {
 	conn, err := grpc.Dial(serverAddr, grpc.WithInsecure())
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
 
-	client := pb.NewExperimentServiceClient(conn)
-	stream, err := client.Connect(context.Background())
-	if err != nil {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Client{
+		conn:         conn,
+		client:       pb.NewExperimentServiceClient(conn),
+		ctx:          ctx,
+		cancel:       cancel,
+		username:     username,
+		experimentID: experimentID,
+		start:        make(chan *pb.ExperimentStarted, 1),
+		msg:          make(chan *pb.ServerMessage, 1),
+		over:         make(chan struct{}),
+	}
+	if err := c.openStream(); err != nil {
+		cancel()
 		conn.Close()
-		return nil, fmt.Errorf("failed to create stream: %w", err)
+		return nil, err
 	}
+	return c, nil
+}
 
-	// Send the initial message with the username
-	err = stream.Send(&pb.ClientMessage{Username: username})
+// openStream opens a new stream to the server and joins the experiment, resuming the session if possible
+func (c *Client) openStream() error {
+	stream, err := c.client.Connect(c.ctx)
 	if err != nil {
-		conn.Close()
-		return nil, fmt.Errorf("failed to send username: %w", err)
+		return fmt.Errorf("failed to create stream: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Send the initial message with the username and the experiment to join
+	err = stream.Send(&pb.ClientMessage{
+		Username:     c.username,
+		ExperimentId: c.experimentID,
+		SessionToken: c.sessionToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send username: %w", err)
+	}
+
+	c.stream = stream
+	return nil
+}
+
+// reconnect tries to open a new stream with exponential backoff until it succeeds or the client is closed
+func (c *Client) reconnect() bool {
+	delay := initialBackoff
+	for {
+		log.Printf("Reconnecting in %v...", delay)
+		select {
+		case <-c.ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+
+		err := c.openStream()
+		if err == nil {
+			log.Println("Reconnected to server")
+			return true
+		}
+		log.Printf("Failed to reconnect: %v", err)
+		delay = min(delay*2, maxBackoff)
 	}
+}
+
+func (c *Client) getStream() pb.ExperimentService_ConnectClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	return &Client{
-		conn:     conn,
-		client:   client,
-		stream:   stream,
-		username: username,
-		start:    make(chan struct{}),
-		msg:      make(chan string),
-	}, nil
+	return c.stream
 }
 
 // Close gracefully closes the client connection
 func (c *Client) Close() {
-	c.stream.CloseSend()
+	c.cancel()
+	c.getStream().CloseSend()
 	c.conn.Close()
 }
 
 func (c *Client) Guess() {
 	reader := bufio.NewReader(os.Stdin)
 	for {
-		fmt.Print("Enter your guess (1-100): ")
+		fmt.Printf("Enter your guess (%d-%d): ", c.min, c.max)
 		guessStr, _ := reader.ReadString('\n')
 		guessStr = strings.TrimSpace(guessStr)
 
 		// Validate the input
 		guess, err := strconv.Atoi(guessStr)
-		if err != nil || guess < 1 || guess > 100 {
-			fmt.Println("Invalid input. Please enter a number between 1 and 100.")
+		if err != nil || guess < c.min || guess > c.max {
+			fmt.Printf("Invalid input. Please enter a number between %d and %d.\n", c.min, c.max)
 			continue
 		}
 
 		// Send the guess to the server using the stream
-		err = c.stream.Send(&pb.ClientMessage{
-			Username: c.username,
-			Number:   int32(guess),
-		})
+		err = c.SendGuess(int32(guess))
 		if err != nil {
 			log.Printf("Failed to send guess: %v", err)
 		}
@@ -90,37 +156,151 @@ func (c *Client) Guess() {
 // ListenAndInteract listens for responses from the server and interacts with the user
 func (c *Client) ListenForMessages() {
 	for {
-		serverMsg, err := c.stream.Recv()
+		serverMsg, err := c.getStream().Recv()
 		if err != nil {
+			if c.ctx.Err() != nil {
+				return // The client is closed
+			}
+			if status.Code(err) == codes.AlreadyExists {
+				log.Fatalf("Username '%s' is already taken, please choose another one", c.username)
+			}
+			if status.Code(err) == codes.Aborted {
+				log.Fatalf("Session was resumed by another connection")
+			}
 			log.Printf("Failed to receive message from server: %v", err)
-			break
+			if !c.reconnect() {
+				return
+			}
+			continue
 		}
 		fmt.Printf("Server: %s\n", serverMsg.Message)
 
 		// Check if the experiment has started
-		if strings.Contains(serverMsg.Message, "Experiment started") {
-			c.start <- struct{}{}
-		} else {
-			c.msg <- serverMsg.Message
+		switch payload := serverMsg.Payload.(type) {
+		case *pb.ServerMessage_Joined:
+			c.mu.Lock()
+			c.sessionToken = payload.Joined.SessionToken
+			c.mu.Unlock()
+		case *pb.ServerMessage_ExperimentStarted:
+			// The start is announced again when the session is resumed, nobody waits for it then
+			select {
+			case c.start <- payload.ExperimentStarted:
+			default:
+			}
+		case *pb.ServerMessage_TimeRemaining, *pb.ServerMessage_GuessAccepted:
+			// Already printed above, nobody waits for it
+		case *pb.ServerMessage_ServerShutdown:
+			// The stream is closed next, the client reconnects once the server is back
+		case *pb.ServerMessage_ExperimentEnded:
+			printResults(payload.ExperimentEnded)
+			c.finish()
+		case *pb.ServerMessage_Eliminated:
+			c.finish()
+		default:
+			c.deliver(serverMsg)
+		}
+	}
+}
+
+// deliver passes the message to the user waiting for a response. The messages that arrive while the user
+// is typing a guess are only printed, the listener must never block on them
+func (c *Client) deliver(msg *pb.ServerMessage) {
+	select {
+	case c.msg <- msg:
+	default:
+	}
+}
+
+// finish marks the experiment as over for the client
+func (c *Client) finish() {
+	c.overOnce.Do(func() {
+		close(c.over)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if !c.waiting {
+			fmt.Println("The experiment is over, press Enter to exit")
 		}
+	})
+}
+
+// Over reports whether the experiment is over for the client
+func (c *Client) Over() bool {
+	select {
+	case <-c.over:
+		return true
+	default:
+		return false
 	}
 }
 
-func (c *Client) WaitForStart() {
-	<-c.start
+func (c *Client) WaitForStart() *pb.ExperimentStarted {
+	started := <-c.start
+	c.min, c.max = int(started.Min), int(started.Max)
+	c.maxGuesses = int(started.MaxGuesses)
+	return started
+}
+
+// DiscardMessages drops the messages that arrived while nobody was waiting, so that they are not taken
+// for the response to the next guess
+func (c *Client) DiscardMessages() {
+	select {
+	case <-c.msg:
+	default:
+	}
 }
 
-func (c *Client) WaitForMessage() string {
-	return <-c.msg
+// WaitForMessage waits for the response to the guess, or returns nil if the experiment is over
+func (c *Client) WaitForMessage() *pb.ServerMessage {
+	c.mu.Lock()
+	c.waiting = true
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.waiting = false
+		c.mu.Unlock()
+	}()
+
+	select {
+	case msg := <-c.msg:
+		return msg
+	case <-c.over:
+		return nil
+	}
 }
 
 func (c *Client) SendGuess(guess int32) error {
-	return c.stream.Send(&pb.ClientMessage{
+	return c.getStream().Send(&pb.ClientMessage{
 		Username: c.username,
 		Number:   guess,
 	})
 }
 
+// printResults prints the final ranking of the experiment
+func printResults(ended *pb.ExperimentEnded) {
+	fmt.Printf("The number was %d. Results:\n", ended.Target)
+	for _, result := range ended.Results {
+		if result.Won {
+			fmt.Printf("%d. %s: won in %d guesses (%v)\n", result.Rank, result.Username, result.Guesses,
+				result.TimeToSolve.AsDuration().Round(time.Millisecond))
+		} else if result.Eliminated {
+			fmt.Printf("%d. %s: eliminated after %d guesses\n", result.Rank, result.Username, result.Guesses)
+		} else {
+			fmt.Printf("%d. %s: did not win, %d guesses\n", result.Rank, result.Username, result.Guesses)
+		}
+	}
+}
+
+// experimentOver reports whether the message finishes the experiment for the client
+func experimentOver(msg *pb.ServerMessage) bool {
+	switch payload := msg.Payload.(type) {
+	case *pb.ServerMessage_GuessResult:
+		return payload.GuessResult.Verdict == pb.Verdict_CORRECT
+	case *pb.ServerMessage_ExperimentEnded, *pb.ServerMessage_Eliminated:
+		return true
+	}
+	return false
+}
+
 func main() {
 	// Get the username from the user
 	reader := bufio.NewReader(os.Stdin)
@@ -128,11 +308,16 @@ func main() {
 	username, _ := reader.ReadString('\n')
 	username = strings.TrimSpace(username)
 
+	// Get the ID of the experiment to join
+	fmt.Print("Enter experiment ID (leave empty for default): ")
+	experimentID, _ := reader.ReadString('\n')
+	experimentID = strings.TrimSpace(experimentID)
+
 	// Address of the server
 	serverAddr := "localhost:50051"
 
 	// Initialize client with the username
-	client, err := NewClient(serverAddr, username)
+	client, err := NewClient(serverAddr, username, experimentID)
 	if err != nil {
 		log.Fatalf("Error initializing client: %v", err)
 	}
@@ -156,30 +341,40 @@ func main() {
 	client.WaitForStart()
 
 	guesses := make([]int, 0)
-	for {
-		fmt.Print("Enter your guess (1-100): ")
+	for !client.Over() {
+		fmt.Printf("Enter your guess (%d-%d): ", client.min, client.max)
 		guessStr, _ := reader.ReadString('\n')
 		guessStr = strings.TrimSpace(guessStr)
+		if client.Over() {
+			break
+		}
 
 		guess, err := strconv.Atoi(guessStr)
-		if err != nil || guess < 1 || guess > 100 {
-			fmt.Println("Invalid input. Please enter a number between 1 and 100.")
+		if err != nil || guess < client.min || guess > client.max {
+			fmt.Printf("Invalid input. Please enter a number between %d and %d.\n", client.min, client.max)
 			continue
 		}
 
+		client.DiscardMessages()
 		err = client.SendGuess(int32(guess))
 		if err != nil {
 			log.Printf("Failed to send guess: %v", err)
-			break
+			fmt.Println("Connection to the server is lost, please try again once it is restored.")
+			continue
 		}
 		guesses = append(guesses, guess)
 
 		fmt.Println("Waiting for response...")
 		msg := client.WaitForMessage()
-		if msg == "Correct!" || strings.Contains(msg, "Experiment started") {
+		if msg == nil || experimentOver(msg) {
 			fmt.Println("Experiment ended")
 			break
 		}
+		if client.maxGuesses > 0 && len(guesses) >= client.maxGuesses {
+			// The last guess was wrong, the server eliminates the client
+			fmt.Println("No guesses left")
+			break
+		}
 	}
 
 	fmt.Println("My guesses: ", guesses)
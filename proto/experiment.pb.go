@@ -9,6 +9,8 @@ package __
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,10 +22,227 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ResponseMode int32
+
+const (
+	ResponseMode_MANUAL    ResponseMode = 0 // Guesses are answered by an operator with SendResponse
+	ResponseMode_AUTOMATIC ResponseMode = 1 // Guesses are answered as soon as they are received
+	ResponseMode_DELAYED   ResponseMode = 2 // Guesses are answered automatically after response_delay_ms
+)
+
+// Enum value maps for ResponseMode.
+var (
+	ResponseMode_name = map[int32]string{
+		0: "MANUAL",
+		1: "AUTOMATIC",
+		2: "DELAYED",
+	}
+	ResponseMode_value = map[string]int32{
+		"MANUAL":    0,
+		"AUTOMATIC": 1,
+		"DELAYED":   2,
+	}
+)
+
+func (x ResponseMode) Enum() *ResponseMode {
+	p := new(ResponseMode)
+	*p = x
+	return p
+}
+
+func (x ResponseMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_experiment_proto_enumTypes[0].Descriptor()
+}
+
+func (ResponseMode) Type() protoreflect.EnumType {
+	return &file_proto_experiment_proto_enumTypes[0]
+}
+
+func (x ResponseMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseMode.Descriptor instead.
+func (ResponseMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{0}
+}
+
+type Verdict int32
+
+const (
+	Verdict_VERDICT_UNSPECIFIED Verdict = 0
+	Verdict_HIGHER              Verdict = 1 // The target number is higher than the guess
+	Verdict_LOWER               Verdict = 2 // The target number is lower than the guess
+	Verdict_CORRECT             Verdict = 3 // The guess is correct
+)
+
+// Enum value maps for Verdict.
+var (
+	Verdict_name = map[int32]string{
+		0: "VERDICT_UNSPECIFIED",
+		1: "HIGHER",
+		2: "LOWER",
+		3: "CORRECT",
+	}
+	Verdict_value = map[string]int32{
+		"VERDICT_UNSPECIFIED": 0,
+		"HIGHER":              1,
+		"LOWER":               2,
+		"CORRECT":             3,
+	}
+)
+
+func (x Verdict) Enum() *Verdict {
+	p := new(Verdict)
+	*p = x
+	return p
+}
+
+func (x Verdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Verdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_experiment_proto_enumTypes[1].Descriptor()
+}
+
+func (Verdict) Type() protoreflect.EnumType {
+	return &file_proto_experiment_proto_enumTypes[1]
+}
+
+func (x Verdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Verdict.Descriptor instead.
+func (Verdict) EnumDescriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{1}
+}
+
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	ErrorCode_SESSION_TAKEN_OVER     ErrorCode = 1 // The session was resumed by another connection
+	ErrorCode_GUESS_LIMIT_REACHED    ErrorCode = 2 // The participant has used all the guesses allowed in the experiment
+	ErrorCode_EXPERIMENT_NOT_ACTIVE  ErrorCode = 3 // The guess was sent before the experiment started or after it ended
+	ErrorCode_GUESS_OUT_OF_RANGE     ErrorCode = 4 // The guess is outside the range of the experiment
+	ErrorCode_ALREADY_SOLVED         ErrorCode = 5 // The participant has already guessed the number
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNSPECIFIED",
+		1: "SESSION_TAKEN_OVER",
+		2: "GUESS_LIMIT_REACHED",
+		3: "EXPERIMENT_NOT_ACTIVE",
+		4: "GUESS_OUT_OF_RANGE",
+		5: "ALREADY_SOLVED",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED": 0,
+		"SESSION_TAKEN_OVER":     1,
+		"GUESS_LIMIT_REACHED":    2,
+		"EXPERIMENT_NOT_ACTIVE":  3,
+		"GUESS_OUT_OF_RANGE":     4,
+		"ALREADY_SOLVED":         5,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_experiment_proto_enumTypes[2].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_experiment_proto_enumTypes[2]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{2}
+}
+
+type ConnectionState int32
+
+const (
+	ConnectionState_CONNECTION_STATE_UNSPECIFIED ConnectionState = 0
+	ConnectionState_CONNECTED                    ConnectionState = 1
+	ConnectionState_DISCONNECTED                 ConnectionState = 2
+)
+
+// Enum value maps for ConnectionState.
+var (
+	ConnectionState_name = map[int32]string{
+		0: "CONNECTION_STATE_UNSPECIFIED",
+		1: "CONNECTED",
+		2: "DISCONNECTED",
+	}
+	ConnectionState_value = map[string]int32{
+		"CONNECTION_STATE_UNSPECIFIED": 0,
+		"CONNECTED":                    1,
+		"DISCONNECTED":                 2,
+	}
+)
+
+func (x ConnectionState) Enum() *ConnectionState {
+	p := new(ConnectionState)
+	*p = x
+	return p
+}
+
+func (x ConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_experiment_proto_enumTypes[3].Descriptor()
+}
+
+func (ConnectionState) Type() protoreflect.EnumType {
+	return &file_proto_experiment_proto_enumTypes[3]
+}
+
+func (x ConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionState.Descriptor instead.
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{3}
+}
+
 type StartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ExperimentId    string               `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment to start, "default" if empty
+	ResponseMode    ResponseMode         `protobuf:"varint,2,opt,name=response_mode,json=responseMode,proto3,enum=experiment.ResponseMode" json:"response_mode,omitempty"`
+	ResponseDelayMs int32                `protobuf:"varint,3,opt,name=response_delay_ms,json=responseDelayMs,proto3" json:"response_delay_ms,omitempty"` // Delay before answering a guess in the DELAYED mode
+	Min             *int32               `protobuf:"varint,4,opt,name=min,proto3,oneof" json:"min,omitempty"`                                            // Lower bound of the number to guess, inclusive, 1 if not set
+	Max             *int32               `protobuf:"varint,5,opt,name=max,proto3,oneof" json:"max,omitempty"`                                            // Upper bound of the number to guess, inclusive, 100 if not set
+	Target          *int32               `protobuf:"varint,6,opt,name=target,proto3,oneof" json:"target,omitempty"`                                      // Number to guess, chosen randomly within the range if not set
+	Duration        *durationpb.Duration `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`                                         // The experiment ends automatically after it, no time limit if not set
+	MaxGuesses      int32                `protobuf:"varint,8,opt,name=max_guesses,json=maxGuesses,proto3" json:"max_guesses,omitempty"`                  // Number of guesses allowed for each participant, no limit if 0
 }
 
 func (x *StartRequest) Reset() {
@@ -56,12 +275,69 @@ func (*StartRequest) Descriptor() ([]byte, []int) {
 	return file_proto_experiment_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *StartRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *StartRequest) GetResponseMode() ResponseMode {
+	if x != nil {
+		return x.ResponseMode
+	}
+	return ResponseMode_MANUAL
+}
+
+func (x *StartRequest) GetResponseDelayMs() int32 {
+	if x != nil {
+		return x.ResponseDelayMs
+	}
+	return 0
+}
+
+func (x *StartRequest) GetMin() int32 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *StartRequest) GetMax() int32 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *StartRequest) GetTarget() int32 {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return 0
+}
+
+func (x *StartRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *StartRequest) GetMaxGuesses() int32 {
+	if x != nil {
+		return x.MaxGuesses
+	}
+	return 0
+}
+
 type StartResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ExperimentId string `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the started experiment
 }
 
 func (x *StartResponse) Reset() {
@@ -101,10 +377,19 @@ func (x *StartResponse) GetMessage() string {
 	return ""
 }
 
+func (x *StartResponse) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
 type EndRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment to end, "default" if empty
 }
 
 func (x *EndRequest) Reset() {
@@ -137,12 +422,20 @@ func (*EndRequest) Descriptor() ([]byte, []int) {
 	return file_proto_experiment_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *EndRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
 type EndResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Message string           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Result  *ExperimentEnded `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"` // The same summary that is sent to the clients
 }
 
 func (x *EndResponse) Reset() {
@@ -182,13 +475,22 @@ func (x *EndResponse) GetMessage() string {
 	return ""
 }
 
+func (x *EndResponse) GetResult() *ExperimentEnded {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type ClientMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // Username of the client
-	Number   int32  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`    // The number guessed by the client
+	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                             // Username of the client
+	Number       int32  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`                                // The number guessed by the client
+	ExperimentId string `protobuf:"bytes,3,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment to join, sent with the first message ("default" if empty)
+	SessionToken string `protobuf:"bytes,4,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // Token from Joined to resume the session, sent with the first message
 }
 
 func (x *ClientMessage) Reset() {
@@ -235,12 +537,37 @@ func (x *ClientMessage) GetNumber() int32 {
 	return 0
 }
 
+func (x *ClientMessage) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *ClientMessage) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
 type ServerMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Message from the server (e.g., "Higher", "Lower", "Correct")
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Human-readable message from the server (e.g., "Higher!", "Lower!", "Correct!")
+	// Types that are assignable to Payload:
+	//	*ServerMessage_ExperimentStarted
+	//	*ServerMessage_GuessResult
+	//	*ServerMessage_ExperimentEnded
+	//	*ServerMessage_Error
+	//	*ServerMessage_Joined
+	//	*ServerMessage_TimeRemaining
+	//	*ServerMessage_Eliminated
+	//	*ServerMessage_ServerShutdown
+	//	*ServerMessage_GuessAccepted
+	Payload isServerMessage_Payload `protobuf_oneof:"payload"`
 }
 
 func (x *ServerMessage) Reset() {
@@ -280,28 +607,158 @@ func (x *ServerMessage) GetMessage() string {
 	return ""
 }
 
-type SendResponseRequest struct {
+func (m *ServerMessage) GetPayload() isServerMessage_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetExperimentStarted() *ExperimentStarted {
+	if x, ok := x.GetPayload().(*ServerMessage_ExperimentStarted); ok {
+		return x.ExperimentStarted
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetGuessResult() *GuessResult {
+	if x, ok := x.GetPayload().(*ServerMessage_GuessResult); ok {
+		return x.GuessResult
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetExperimentEnded() *ExperimentEnded {
+	if x, ok := x.GetPayload().(*ServerMessage_ExperimentEnded); ok {
+		return x.ExperimentEnded
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetError() *Error {
+	if x, ok := x.GetPayload().(*ServerMessage_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetJoined() *Joined {
+	if x, ok := x.GetPayload().(*ServerMessage_Joined); ok {
+		return x.Joined
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetTimeRemaining() *TimeRemaining {
+	if x, ok := x.GetPayload().(*ServerMessage_TimeRemaining); ok {
+		return x.TimeRemaining
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetEliminated() *Eliminated {
+	if x, ok := x.GetPayload().(*ServerMessage_Eliminated); ok {
+		return x.Eliminated
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetServerShutdown() *ServerShutdown {
+	if x, ok := x.GetPayload().(*ServerMessage_ServerShutdown); ok {
+		return x.ServerShutdown
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetGuessAccepted() *GuessAccepted {
+	if x, ok := x.GetPayload().(*ServerMessage_GuessAccepted); ok {
+		return x.GuessAccepted
+	}
+	return nil
+}
+
+type isServerMessage_Payload interface {
+	isServerMessage_Payload()
+}
+
+type ServerMessage_ExperimentStarted struct {
+	ExperimentStarted *ExperimentStarted `protobuf:"bytes,2,opt,name=experiment_started,json=experimentStarted,proto3,oneof"`
+}
+
+type ServerMessage_GuessResult struct {
+	GuessResult *GuessResult `protobuf:"bytes,3,opt,name=guess_result,json=guessResult,proto3,oneof"`
+}
+
+type ServerMessage_ExperimentEnded struct {
+	ExperimentEnded *ExperimentEnded `protobuf:"bytes,4,opt,name=experiment_ended,json=experimentEnded,proto3,oneof"`
+}
+
+type ServerMessage_Error struct {
+	Error *Error `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
+}
+
+type ServerMessage_Joined struct {
+	Joined *Joined `protobuf:"bytes,6,opt,name=joined,proto3,oneof"`
+}
+
+type ServerMessage_TimeRemaining struct {
+	TimeRemaining *TimeRemaining `protobuf:"bytes,7,opt,name=time_remaining,json=timeRemaining,proto3,oneof"`
+}
+
+type ServerMessage_Eliminated struct {
+	Eliminated *Eliminated `protobuf:"bytes,8,opt,name=eliminated,proto3,oneof"`
+}
+
+type ServerMessage_ServerShutdown struct {
+	ServerShutdown *ServerShutdown `protobuf:"bytes,9,opt,name=server_shutdown,json=serverShutdown,proto3,oneof"`
+}
+
+type ServerMessage_GuessAccepted struct {
+	GuessAccepted *GuessAccepted `protobuf:"bytes,10,opt,name=guess_accepted,json=guessAccepted,proto3,oneof"`
+}
+
+func (*ServerMessage_ExperimentStarted) isServerMessage_Payload() {}
+
+func (*ServerMessage_GuessResult) isServerMessage_Payload() {}
+
+func (*ServerMessage_ExperimentEnded) isServerMessage_Payload() {}
+
+func (*ServerMessage_Error) isServerMessage_Payload() {}
+
+func (*ServerMessage_Joined) isServerMessage_Payload() {}
+
+func (*ServerMessage_TimeRemaining) isServerMessage_Payload() {}
+
+func (*ServerMessage_Eliminated) isServerMessage_Payload() {}
+
+func (*ServerMessage_ServerShutdown) isServerMessage_Payload() {}
+
+func (*ServerMessage_GuessAccepted) isServerMessage_Payload() {}
+
+type Joined struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // Username of the client
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // Token to resume the session under the same username
+	Resumed      bool   `protobuf:"varint,3,opt,name=resumed,proto3" json:"resumed,omitempty"`                              // Whether an existing session was resumed
 }
 
-func (x *SendResponseRequest) Reset() {
-	*x = SendResponseRequest{}
+func (x *Joined) Reset() {
+	*x = Joined{}
 	mi := &file_proto_experiment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendResponseRequest) String() string {
+func (x *Joined) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendResponseRequest) ProtoMessage() {}
+func (*Joined) ProtoMessage() {}
 
-func (x *SendResponseRequest) ProtoReflect() protoreflect.Message {
+func (x *Joined) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_experiment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,40 +770,58 @@ func (x *SendResponseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendResponseRequest.ProtoReflect.Descriptor instead.
-func (*SendResponseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Joined.ProtoReflect.Descriptor instead.
+func (*Joined) Descriptor() ([]byte, []int) {
 	return file_proto_experiment_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SendResponseRequest) GetUsername() string {
+func (x *Joined) GetExperimentId() string {
 	if x != nil {
-		return x.Username
+		return x.ExperimentId
 	}
 	return ""
 }
 
-type SendResponseResponse struct {
+func (x *Joined) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *Joined) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+type ExperimentStarted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ExperimentId string                 `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Min          int32                  `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`                                 // Lower bound of the number to guess, inclusive
+	Max          int32                  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`                                 // Upper bound of the number to guess, inclusive
+	Deadline     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`                        // When the experiment ends automatically, unset if there is no time limit
+	MaxGuesses   int32                  `protobuf:"varint,5,opt,name=max_guesses,json=maxGuesses,proto3" json:"max_guesses,omitempty"` // Number of guesses allowed for each participant, no limit if 0
 }
 
-func (x *SendResponseResponse) Reset() {
-	*x = SendResponseResponse{}
+func (x *ExperimentStarted) Reset() {
+	*x = ExperimentStarted{}
 	mi := &file_proto_experiment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendResponseResponse) String() string {
+func (x *ExperimentStarted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendResponseResponse) ProtoMessage() {}
+func (*ExperimentStarted) ProtoMessage() {}
 
-func (x *SendResponseResponse) ProtoReflect() protoreflect.Message {
+func (x *ExperimentStarted) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_experiment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -358,39 +833,1864 @@ func (x *SendResponseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendResponseResponse.ProtoReflect.Descriptor instead.
-func (*SendResponseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperimentStarted.ProtoReflect.Descriptor instead.
+func (*ExperimentStarted) Descriptor() ([]byte, []int) {
 	return file_proto_experiment_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SendResponseResponse) GetMessage() string {
+func (x *ExperimentStarted) GetExperimentId() string {
 	if x != nil {
-		return x.Message
+		return x.ExperimentId
 	}
 	return ""
 }
 
-type WaitingListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExperimentStarted) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ExperimentStarted) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ExperimentStarted) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *ExperimentStarted) GetMaxGuesses() int32 {
+	if x != nil {
+		return x.MaxGuesses
+	}
+	return 0
+}
+
+// Sent periodically to the clients of an experiment with a time limit
+type TimeRemaining struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining *durationpb.Duration   `protobuf:"bytes,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Deadline  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *TimeRemaining) Reset() {
+	*x = TimeRemaining{}
+	mi := &file_proto_experiment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRemaining) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRemaining) ProtoMessage() {}
+
+func (x *TimeRemaining) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRemaining.ProtoReflect.Descriptor instead.
+func (*TimeRemaining) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimeRemaining) GetRemaining() *durationpb.Duration {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *TimeRemaining) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+// Sent when the guess is queued for a response
+type GuessAccepted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuessId string `protobuf:"bytes,1,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"` // ID of the guess, repeated in its GuessResult
+	Guess   int32  `protobuf:"varint,2,opt,name=guess,proto3" json:"guess,omitempty"`
+	Attempt int32  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"` // Number of the guess among the guesses of the client in the experiment
+}
+
+func (x *GuessAccepted) Reset() {
+	*x = GuessAccepted{}
+	mi := &file_proto_experiment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessAccepted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessAccepted) ProtoMessage() {}
+
+func (x *GuessAccepted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessAccepted.ProtoReflect.Descriptor instead.
+func (*GuessAccepted) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GuessAccepted) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+func (x *GuessAccepted) GetGuess() int32 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+func (x *GuessAccepted) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type GuessResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guess    int32   `protobuf:"varint,1,opt,name=guess,proto3" json:"guess,omitempty"` // The number guessed by the client
+	Verdict  Verdict `protobuf:"varint,2,opt,name=verdict,proto3,enum=experiment.Verdict" json:"verdict,omitempty"`
+	Attempts int32   `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`             // Number of guesses made by the client in the experiment, up to the answered one
+	GuessId  string  `protobuf:"bytes,4,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"` // ID of the answered guess from GuessAccepted
+}
+
+func (x *GuessResult) Reset() {
+	*x = GuessResult{}
+	mi := &file_proto_experiment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessResult) ProtoMessage() {}
+
+func (x *GuessResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessResult.ProtoReflect.Descriptor instead.
+func (*GuessResult) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GuessResult) GetGuess() int32 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+func (x *GuessResult) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+func (x *GuessResult) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GuessResult) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+type ExperimentEnded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string                 `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Target       int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"` // The number that had to be guessed
+	Summary      string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Results      []*ParticipantResult   `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"` // Final ranking, the best first
+}
+
+func (x *ExperimentEnded) Reset() {
+	*x = ExperimentEnded{}
+	mi := &file_proto_experiment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentEnded) ProtoMessage() {}
+
+func (x *ExperimentEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentEnded.ProtoReflect.Descriptor instead.
+func (*ExperimentEnded) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExperimentEnded) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *ExperimentEnded) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ExperimentEnded) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ExperimentEnded) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ExperimentEnded) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *ExperimentEnded) GetResults() []*ParticipantResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Winners are ranked by the number of guesses, then by the time to solve. The rest share the last rank
+type ParticipantResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank        int32                `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Username    string               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Guesses     int32                `protobuf:"varint,3,opt,name=guesses,proto3" json:"guesses,omitempty"`
+	Won         bool                 `protobuf:"varint,4,opt,name=won,proto3" json:"won,omitempty"`
+	TimeToSolve *durationpb.Duration `protobuf:"bytes,5,opt,name=time_to_solve,json=timeToSolve,proto3" json:"time_to_solve,omitempty"` // Time from the start to the correct guess, unset if not won
+	Eliminated  bool                 `protobuf:"varint,6,opt,name=eliminated,proto3" json:"eliminated,omitempty"`                       // Used all the guesses without guessing the number
+	Overridden  bool                 `protobuf:"varint,7,opt,name=overridden,proto3" json:"overridden,omitempty"`                       // Some responses to the participant were overridden, set only in the results for the operators
+}
+
+func (x *ParticipantResult) Reset() {
+	*x = ParticipantResult{}
+	mi := &file_proto_experiment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantResult) ProtoMessage() {}
+
+func (x *ParticipantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantResult.ProtoReflect.Descriptor instead.
+func (*ParticipantResult) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ParticipantResult) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ParticipantResult) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ParticipantResult) GetGuesses() int32 {
+	if x != nil {
+		return x.Guesses
+	}
+	return 0
+}
+
+func (x *ParticipantResult) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+func (x *ParticipantResult) GetTimeToSolve() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToSolve
+	}
+	return nil
+}
+
+func (x *ParticipantResult) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+func (x *ParticipantResult) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Code    ErrorCode `protobuf:"varint,2,opt,name=code,proto3,enum=experiment.ErrorCode" json:"code,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_proto_experiment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Error) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// Sent when the participant has used all the guesses without guessing the number
+type Eliminated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Guesses    int32  `protobuf:"varint,2,opt,name=guesses,proto3" json:"guesses,omitempty"`
+	MaxGuesses int32  `protobuf:"varint,3,opt,name=max_guesses,json=maxGuesses,proto3" json:"max_guesses,omitempty"`
+}
+
+func (x *Eliminated) Reset() {
+	*x = Eliminated{}
+	mi := &file_proto_experiment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Eliminated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eliminated) ProtoMessage() {}
+
+func (x *Eliminated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eliminated.ProtoReflect.Descriptor instead.
+func (*Eliminated) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Eliminated) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Eliminated) GetGuesses() int32 {
+	if x != nil {
+		return x.Guesses
+	}
+	return 0
+}
+
+func (x *Eliminated) GetMaxGuesses() int32 {
+	if x != nil {
+		return x.MaxGuesses
+	}
+	return 0
+}
+
+// Sent before the server stops, the client can reconnect once it is back
+type ServerShutdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ServerShutdown) Reset() {
+	*x = ServerShutdown{}
+	mi := &file_proto_experiment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerShutdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerShutdown) ProtoMessage() {}
+
+func (x *ServerShutdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerShutdown.ProtoReflect.Descriptor instead.
+func (*ServerShutdown) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ServerShutdown) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SendResponseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username     string  `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                             // Username of the client
+	ExperimentId string  `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment the client participates in, "default" if empty
+	GuessId      string  `protobuf:"bytes,3,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"`                // ID of the pending guess to answer, the oldest pending guess of the client if empty
+	ClaimId      string  `protobuf:"bytes,4,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`                // Claim from ClaimPending, required to answer claimed guesses
+	Verdict      Verdict `protobuf:"varint,5,opt,name=verdict,proto3,enum=experiment.Verdict" json:"verdict,omitempty"`      // Verdict to send instead of the true one, the true one if VERDICT_UNSPECIFIED
+	Message      string  `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                               // Text to send instead of the default one for the verdict
+}
+
+func (x *SendResponseRequest) Reset() {
+	*x = SendResponseRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResponseRequest) ProtoMessage() {}
+
+func (x *SendResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResponseRequest.ProtoReflect.Descriptor instead.
+func (*SendResponseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SendResponseRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SendResponseRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *SendResponseRequest) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+func (x *SendResponseRequest) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+func (x *SendResponseRequest) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+func (x *SendResponseRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendResponseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	GuessId string `protobuf:"bytes,2,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"` // ID of the answered guess
+}
+
+func (x *SendResponseResponse) Reset() {
+	*x = SendResponseResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResponseResponse) ProtoMessage() {}
+
+func (x *SendResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResponseResponse.ProtoReflect.Descriptor instead.
+func (*SendResponseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SendResponseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendResponseResponse) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+// Guesses are answered in the order they were received, like with SendResponse
+type SendResponsesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string               `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment, "default" if empty
+	Usernames    []string             `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`                           // Answer only the guesses of these clients, of all the clients if empty
+	MinWait      *durationpb.Duration `protobuf:"bytes,3,opt,name=min_wait,json=minWait,proto3" json:"min_wait,omitempty"`                // Answer only the guesses waiting at least this long, all of them if not set
+	ClaimId      string               `protobuf:"bytes,4,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`                // Answer only the guesses held by this claim from ClaimPending, only the unclaimed guesses if empty
+}
+
+func (x *SendResponsesRequest) Reset() {
+	*x = SendResponsesRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResponsesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResponsesRequest) ProtoMessage() {}
+
+func (x *SendResponsesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResponsesRequest.ProtoReflect.Descriptor instead.
+func (*SendResponsesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SendResponsesRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *SendResponsesRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+func (x *SendResponsesRequest) GetMinWait() *durationpb.Duration {
+	if x != nil {
+		return x.MinWait
+	}
+	return nil
+}
+
+func (x *SendResponsesRequest) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+// Outcome of answering a single guess, or an error for a client without guesses to answer
+type ResponseOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string       `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	GuessId  string       `protobuf:"bytes,2,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"` // Empty if the client has no guesses to answer
+	Result   *GuessResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`                  // Unset if the guess was not answered
+	Error    string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                    // Why the guess was not answered
+}
+
+func (x *ResponseOutcome) Reset() {
+	*x = ResponseOutcome{}
+	mi := &file_proto_experiment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResponseOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseOutcome) ProtoMessage() {}
+
+func (x *ResponseOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseOutcome.ProtoReflect.Descriptor instead.
+func (*ResponseOutcome) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResponseOutcome) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResponseOutcome) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+func (x *ResponseOutcome) GetResult() *GuessResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ResponseOutcome) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SendResponsesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcomes []*ResponseOutcome `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Answered int32              `protobuf:"varint,2,opt,name=answered,proto3" json:"answered,omitempty"` // Number of the answered guesses
+	Failed   int32              `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`     // Number of the outcomes with an error
+}
+
+func (x *SendResponsesResponse) Reset() {
+	*x = SendResponsesResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResponsesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResponsesResponse) ProtoMessage() {}
+
+func (x *SendResponsesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResponsesResponse.ProtoReflect.Descriptor instead.
+func (*SendResponsesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendResponsesResponse) GetOutcomes() []*ResponseOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *SendResponsesResponse) GetAnswered() int32 {
+	if x != nil {
+		return x.Answered
+	}
+	return 0
+}
+
+func (x *SendResponsesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type WaitingListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string               `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment, guesses of all experiments if empty
+	MinWait      *durationpb.Duration `protobuf:"bytes,2,opt,name=min_wait,json=minWait,proto3" json:"min_wait,omitempty"`                // Only the guesses waiting at least this long, all of them if not set
+}
+
+func (x *WaitingListRequest) Reset() {
+	*x = WaitingListRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingListRequest) ProtoMessage() {}
+
+func (x *WaitingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingListRequest.ProtoReflect.Descriptor instead.
+func (*WaitingListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WaitingListRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *WaitingListRequest) GetMinWait() *durationpb.Duration {
+	if x != nil {
+		return x.MinWait
+	}
+	return nil
+}
+
+// A guess awaiting a response
+type WaitingEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username     string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	GuessId      string                 `protobuf:"bytes,2,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"`
+	Guess        int32                  `protobuf:"varint,3,opt,name=guess,proto3" json:"guess,omitempty"`
+	ReceivedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Wait         *durationpb.Duration   `protobuf:"bytes,5,opt,name=wait,proto3" json:"wait,omitempty"`                                     // Time since the guess was received
+	ClaimedUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=claimed_until,json=claimedUntil,proto3" json:"claimed_until,omitempty"` // When the claim of an operator expires, unset if the guess is not claimed
+	ExperimentId string                 `protobuf:"bytes,7,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+}
+
+func (x *WaitingEntry) Reset() {
+	*x = WaitingEntry{}
+	mi := &file_proto_experiment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingEntry) ProtoMessage() {}
+
+func (x *WaitingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingEntry.ProtoReflect.Descriptor instead.
+func (*WaitingEntry) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WaitingEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WaitingEntry) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+func (x *WaitingEntry) GetGuess() int32 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+func (x *WaitingEntry) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *WaitingEntry) GetWait() *durationpb.Duration {
+	if x != nil {
+		return x.Wait
+	}
+	return nil
+}
+
+func (x *WaitingEntry) GetClaimedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedUntil
+	}
+	return nil
+}
+
+func (x *WaitingEntry) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+type WaitingListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usernames []string        `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"` // Clients waiting for responses, the one waiting longest first
+	Entries   []*WaitingEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`     // Guesses awaiting responses, the oldest first
+}
+
+func (x *WaitingListResponse) Reset() {
+	*x = WaitingListResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingListResponse) ProtoMessage() {}
+
+func (x *WaitingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingListResponse.ProtoReflect.Descriptor instead.
+func (*WaitingListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WaitingListResponse) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+func (x *WaitingListResponse) GetEntries() []*WaitingEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Guesses are claimed in the order they were received. A claimed guess can only be answered with its claim
+// until the lease expires, then it can be claimed again
+type ClaimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string               `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment, "default" if empty
+	Limit        int32                `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Maximum number of guesses to claim, 10 if 0
+	Lease        *durationpb.Duration `protobuf:"bytes,3,opt,name=lease,proto3" json:"lease,omitempty"`                                   // How long the guesses stay claimed, 1 minute if not set
+}
+
+func (x *ClaimRequest) Reset() {
+	*x = ClaimRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimRequest) ProtoMessage() {}
+
+func (x *ClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimRequest.ProtoReflect.Descriptor instead.
+func (*ClaimRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClaimRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *ClaimRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ClaimRequest) GetLease() *durationpb.Duration {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type ClaimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClaimId   string                 `protobuf:"bytes,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"` // Pass it to SendResponse to answer the claimed guesses, empty if nothing was claimed
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Entries   []*WaitingEntry        `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"` // Claimed guesses, the oldest first
+}
+
+func (x *ClaimResponse) Reset() {
+	*x = ClaimResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimResponse) ProtoMessage() {}
+
+func (x *ClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimResponse.ProtoReflect.Descriptor instead.
+func (*ClaimResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClaimResponse) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+func (x *ClaimResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ClaimResponse) GetEntries() []*WaitingEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment, the overall leaderboard if empty
+	PageSize     int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`            // Maximum number of entries to return, all of them if 0
+	PageToken    string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`          // next_page_token of the previous page, the first page if empty
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LeaderboardRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Participants are ranked by wins, then by the average and the best number of guesses to win.
+// Participants with the same results share the rank
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username            string  `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Wins                int32   `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	Rank                int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	ExperimentsPlayed   int32   `protobuf:"varint,4,opt,name=experiments_played,json=experimentsPlayed,proto3" json:"experiments_played,omitempty"`
+	TotalGuesses        int32   `protobuf:"varint,5,opt,name=total_guesses,json=totalGuesses,proto3" json:"total_guesses,omitempty"`
+	AverageGuessesToWin float64 `protobuf:"fixed64,6,opt,name=average_guesses_to_win,json=averageGuessesToWin,proto3" json:"average_guesses_to_win,omitempty"` // 0 if the participant has never won
+	BestGuessesToWin    int32   `protobuf:"varint,7,opt,name=best_guesses_to_win,json=bestGuessesToWin,proto3" json:"best_guesses_to_win,omitempty"`           // Fewest guesses needed to win, 0 if the participant has never won
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_proto_experiment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetExperimentsPlayed() int32 {
+	if x != nil {
+		return x.ExperimentsPlayed
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetTotalGuesses() int32 {
+	if x != nil {
+		return x.TotalGuesses
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetAverageGuessesToWin() float64 {
+	if x != nil {
+		return x.AverageGuessesToWin
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetBestGuessesToWin() int32 {
+	if x != nil {
+		return x.BestGuessesToWin
+	}
+	return 0
+}
+
+type LeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Token to request the next page, empty if this is the last one
+	TotalSize     int32               `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // Total number of entries on all pages
+}
+
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardResponse) ProtoMessage() {}
+
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *LeaderboardResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListClientsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment, clients of all experiments if empty
+}
+
+func (x *ListClientsRequest) Reset() {
+	*x = ListClientsRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsRequest) ProtoMessage() {}
+
+func (x *ListClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListClientsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListClientsRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+type ClientInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ExperimentId   string                 `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	State          ConnectionState        `protobuf:"varint,3,opt,name=state,proto3,enum=experiment.ConnectionState" json:"state,omitempty"`
+	ConnectedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	LastSeen       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`                   // Time of the last message received from the client
+	DisconnectedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=disconnected_at,json=disconnectedAt,proto3" json:"disconnected_at,omitempty"` // Not set while the client is connected
+	Guesses        int32                  `protobuf:"varint,7,opt,name=guesses,proto3" json:"guesses,omitempty"`
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	mi := &file_proto_experiment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClientInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetState() ConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ConnectionState_CONNECTION_STATE_UNSPECIFIED
+}
+
+func (x *ClientInfo) GetConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return nil
+}
+
+func (x *ClientInfo) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *ClientInfo) GetDisconnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisconnectedAt
+	}
+	return nil
+}
+
+func (x *ClientInfo) GetGuesses() int32 {
+	if x != nil {
+		return x.Guesses
+	}
+	return 0
+}
+
+type ListClientsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*ClientInfo `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ListClientsResponse) Reset() {
+	*x = ListClientsResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsResponse) ProtoMessage() {}
+
+func (x *ListClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListClientsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListClientsResponse) GetClients() []*ClientInfo {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type WatchEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"` // ID of the experiment to watch, all experiments if empty
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchEventsRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+type ClientConnected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Resumed  bool   `protobuf:"varint,2,opt,name=resumed,proto3" json:"resumed,omitempty"` // Whether the client resumed an existing session
+}
+
+func (x *ClientConnected) Reset() {
+	*x = ClientConnected{}
+	mi := &file_proto_experiment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientConnected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConnected) ProtoMessage() {}
+
+func (x *ClientConnected) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConnected.ProtoReflect.Descriptor instead.
+func (*ClientConnected) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClientConnected) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ClientConnected) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+type ClientDisconnected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ClientDisconnected) Reset() {
+	*x = ClientDisconnected{}
+	mi := &file_proto_experiment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientDisconnected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientDisconnected) ProtoMessage() {}
+
+func (x *ClientDisconnected) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientDisconnected.ProtoReflect.Descriptor instead.
+func (*ClientDisconnected) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClientDisconnected) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GuessReceived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Guess    int32  `protobuf:"varint,2,opt,name=guess,proto3" json:"guess,omitempty"`
+	GuessId  string `protobuf:"bytes,3,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"`
+}
+
+func (x *GuessReceived) Reset() {
+	*x = GuessReceived{}
+	mi := &file_proto_experiment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessReceived) ProtoMessage() {}
+
+func (x *GuessReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessReceived.ProtoReflect.Descriptor instead.
+func (*GuessReceived) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *WaitingListRequest) Reset() {
-	*x = WaitingListRequest{}
-	mi := &file_proto_experiment_proto_msgTypes[8]
+func (x *GuessReceived) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GuessReceived) GetGuess() int32 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+func (x *GuessReceived) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+type ResponseSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username    string       `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Result      *GuessResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Overridden  bool         `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`                                              // The operator replaced the verdict or the text of the response
+	TrueVerdict Verdict      `protobuf:"varint,4,opt,name=true_verdict,json=trueVerdict,proto3,enum=experiment.Verdict" json:"true_verdict,omitempty"` // Verdict computed from the target number
+}
+
+func (x *ResponseSent) Reset() {
+	*x = ResponseSent{}
+	mi := &file_proto_experiment_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaitingListRequest) String() string {
+func (x *ResponseSent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitingListRequest) ProtoMessage() {}
+func (*ResponseSent) ProtoMessage() {}
 
-func (x *WaitingListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_experiment_proto_msgTypes[8]
+func (x *ResponseSent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,34 +2701,226 @@ func (x *WaitingListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitingListRequest.ProtoReflect.Descriptor instead.
-func (*WaitingListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_experiment_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ResponseSent.ProtoReflect.Descriptor instead.
+func (*ResponseSent) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{36}
 }
 
-type WaitingListResponse struct {
+func (x *ResponseSent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResponseSent) GetResult() *GuessResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ResponseSent) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *ResponseSent) GetTrueVerdict() Verdict {
+	if x != nil {
+		return x.TrueVerdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Usernames []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"` // List of clients waiting for responses
+	Time         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	ExperimentId string                 `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Event_ClientConnected
+	//	*Event_ClientDisconnected
+	//	*Event_GuessReceived
+	//	*Event_ResponseSent
+	//	*Event_ExperimentStarted
+	//	*Event_ExperimentEnded
+	//	*Event_ParticipantEliminated
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_proto_experiment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaitingListResponse) Reset() {
-	*x = WaitingListResponse{}
-	mi := &file_proto_experiment_proto_msgTypes[9]
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetClientConnected() *ClientConnected {
+	if x, ok := x.GetPayload().(*Event_ClientConnected); ok {
+		return x.ClientConnected
+	}
+	return nil
+}
+
+func (x *Event) GetClientDisconnected() *ClientDisconnected {
+	if x, ok := x.GetPayload().(*Event_ClientDisconnected); ok {
+		return x.ClientDisconnected
+	}
+	return nil
+}
+
+func (x *Event) GetGuessReceived() *GuessReceived {
+	if x, ok := x.GetPayload().(*Event_GuessReceived); ok {
+		return x.GuessReceived
+	}
+	return nil
+}
+
+func (x *Event) GetResponseSent() *ResponseSent {
+	if x, ok := x.GetPayload().(*Event_ResponseSent); ok {
+		return x.ResponseSent
+	}
+	return nil
+}
+
+func (x *Event) GetExperimentStarted() *ExperimentStarted {
+	if x, ok := x.GetPayload().(*Event_ExperimentStarted); ok {
+		return x.ExperimentStarted
+	}
+	return nil
+}
+
+func (x *Event) GetExperimentEnded() *ExperimentEnded {
+	if x, ok := x.GetPayload().(*Event_ExperimentEnded); ok {
+		return x.ExperimentEnded
+	}
+	return nil
+}
+
+func (x *Event) GetParticipantEliminated() *Eliminated {
+	if x, ok := x.GetPayload().(*Event_ParticipantEliminated); ok {
+		return x.ParticipantEliminated
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_ClientConnected struct {
+	ClientConnected *ClientConnected `protobuf:"bytes,3,opt,name=client_connected,json=clientConnected,proto3,oneof"`
+}
+
+type Event_ClientDisconnected struct {
+	ClientDisconnected *ClientDisconnected `protobuf:"bytes,4,opt,name=client_disconnected,json=clientDisconnected,proto3,oneof"`
+}
+
+type Event_GuessReceived struct {
+	GuessReceived *GuessReceived `protobuf:"bytes,5,opt,name=guess_received,json=guessReceived,proto3,oneof"`
+}
+
+type Event_ResponseSent struct {
+	ResponseSent *ResponseSent `protobuf:"bytes,6,opt,name=response_sent,json=responseSent,proto3,oneof"`
+}
+
+type Event_ExperimentStarted struct {
+	ExperimentStarted *ExperimentStarted `protobuf:"bytes,7,opt,name=experiment_started,json=experimentStarted,proto3,oneof"`
+}
+
+type Event_ExperimentEnded struct {
+	ExperimentEnded *ExperimentEnded `protobuf:"bytes,8,opt,name=experiment_ended,json=experimentEnded,proto3,oneof"`
+}
+
+type Event_ParticipantEliminated struct {
+	ParticipantEliminated *Eliminated `protobuf:"bytes,9,opt,name=participant_eliminated,json=participantEliminated,proto3,oneof"`
+}
+
+func (*Event_ClientConnected) isEvent_Payload() {}
+
+func (*Event_ClientDisconnected) isEvent_Payload() {}
+
+func (*Event_GuessReceived) isEvent_Payload() {}
+
+func (*Event_ResponseSent) isEvent_Payload() {}
+
+func (*Event_ExperimentStarted) isEvent_Payload() {}
+
+func (*Event_ExperimentEnded) isEvent_Payload() {}
+
+func (*Event_ParticipantEliminated) isEvent_Payload() {}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_proto_experiment_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaitingListResponse) String() string {
+func (x *HistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitingListResponse) ProtoMessage() {}
+func (*HistoryRequest) ProtoMessage() {}
 
-func (x *WaitingListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_experiment_proto_msgTypes[9]
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,39 +2931,44 @@ func (x *WaitingListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitingListResponse.ProtoReflect.Descriptor instead.
-func (*WaitingListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_experiment_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *WaitingListResponse) GetUsernames() []string {
+func (x *HistoryRequest) GetExperimentId() string {
 	if x != nil {
-		return x.Usernames
+		return x.ExperimentId
 	}
-	return nil
+	return ""
 }
 
-type LeaderboardRequest struct {
+// A finished run of the experiment
+type ExperimentRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Target    int32                  `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *LeaderboardRequest) Reset() {
-	*x = LeaderboardRequest{}
-	mi := &file_proto_experiment_proto_msgTypes[10]
+func (x *ExperimentRun) Reset() {
+	*x = ExperimentRun{}
+	mi := &file_proto_experiment_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaderboardRequest) String() string {
+func (x *ExperimentRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaderboardRequest) ProtoMessage() {}
+func (*ExperimentRun) ProtoMessage() {}
 
-func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_experiment_proto_msgTypes[10]
+func (x *ExperimentRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,35 +2979,63 @@ func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
-func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
-	return file_proto_experiment_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExperimentRun.ProtoReflect.Descriptor instead.
+func (*ExperimentRun) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{39}
 }
 
-type LeaderboardEntry struct {
+func (x *ExperimentRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ExperimentRun) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *ExperimentRun) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type GuessRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Wins     int32  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	GuessId     string                 `protobuf:"bytes,1,opt,name=guess_id,json=guessId,proto3" json:"guess_id,omitempty"`
+	Username    string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Guess       int32                  `protobuf:"varint,3,opt,name=guess,proto3" json:"guess,omitempty"`
+	ReceivedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Verdict     Verdict                `protobuf:"varint,5,opt,name=verdict,proto3,enum=experiment.Verdict" json:"verdict,omitempty"`                            // VERDICT_UNSPECIFIED if the guess was not answered
+	RespondedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`                          // Unset if the guess was not answered
+	Overridden  bool                   `protobuf:"varint,7,opt,name=overridden,proto3" json:"overridden,omitempty"`                                              // The operator replaced the verdict or the text of the response
+	TrueVerdict Verdict                `protobuf:"varint,8,opt,name=true_verdict,json=trueVerdict,proto3,enum=experiment.Verdict" json:"true_verdict,omitempty"` // Verdict computed from the target number, may differ from the sent one if overridden
+	Message     string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`                                                     // Text sent instead of the default one, empty if not replaced
 }
 
-func (x *LeaderboardEntry) Reset() {
-	*x = LeaderboardEntry{}
-	mi := &file_proto_experiment_proto_msgTypes[11]
+func (x *GuessRecord) Reset() {
+	*x = GuessRecord{}
+	mi := &file_proto_experiment_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaderboardEntry) String() string {
+func (x *GuessRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaderboardEntry) ProtoMessage() {}
+func (*GuessRecord) ProtoMessage() {}
 
-func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_experiment_proto_msgTypes[11]
+func (x *GuessRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,48 +3046,99 @@ func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
-func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
-	return file_proto_experiment_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GuessRecord.ProtoReflect.Descriptor instead.
+func (*GuessRecord) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LeaderboardEntry) GetUsername() string {
+func (x *GuessRecord) GetGuessId() string {
+	if x != nil {
+		return x.GuessId
+	}
+	return ""
+}
+
+func (x *GuessRecord) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *LeaderboardEntry) GetWins() int32 {
+func (x *GuessRecord) GetGuess() int32 {
 	if x != nil {
-		return x.Wins
+		return x.Guess
 	}
 	return 0
 }
 
-type LeaderboardResponse struct {
+func (x *GuessRecord) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *GuessRecord) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+func (x *GuessRecord) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *GuessRecord) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *GuessRecord) GetTrueVerdict() Verdict {
+	if x != nil {
+		return x.TrueVerdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+func (x *GuessRecord) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	ExperimentId string           `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Runs         []*ExperimentRun `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`       // Finished runs, in the order they ended
+	Guesses      []*GuessRecord   `protobuf:"bytes,3,rep,name=guesses,proto3" json:"guesses,omitempty"` // All the guesses, in the order they were received
 }
 
-func (x *LeaderboardResponse) Reset() {
-	*x = LeaderboardResponse{}
-	mi := &file_proto_experiment_proto_msgTypes[12]
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_proto_experiment_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaderboardResponse) String() string {
+func (x *HistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaderboardResponse) ProtoMessage() {}
+func (*HistoryResponse) ProtoMessage() {}
 
-func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_experiment_proto_msgTypes[12]
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_experiment_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,14 +3149,28 @@ func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
-func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
-	return file_proto_experiment_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_experiment_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
+func (x *HistoryResponse) GetExperimentId() string {
 	if x != nil {
-		return x.Entries
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *HistoryResponse) GetRuns() []*ExperimentRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *HistoryResponse) GetGuesses() []*GuessRecord {
+	if x != nil {
+		return x.Guesses
 	}
 	return nil
 }
@@ -590,73 +3180,536 @@ var File_proto_experiment_proto protoreflect.FileDescriptor
 var file_proto_experiment_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
 	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x47, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x22, 0x4e, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x0c, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27, 0x0a,
-	0x0b, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x0b, 0x45, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x0d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x53, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x57,
-	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x33, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x10,
-	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x77, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73,
-	0x22, 0x4d, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x32,
-	0xd5, 0x03, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x12, 0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e,
-	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x03, 0x5a, 0x01, 0x2e, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xee, 0x04, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x4e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x48, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e,
+	0x65, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x45, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x42, 0x0a, 0x0e, 0x67, 0x75, 0x65, 0x73, 0x73,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x73, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x67, 0x75,
+	0x65, 0x73, 0x73, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x6c, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x47, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a,
+	0x0d, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x37,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22,
+	0x5a, 0x0a, 0x0d, 0x47, 0x75, 0x65, 0x73, 0x73, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x75, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x0b,
+	0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x75, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73,
+	0x73, 0x12, 0x2d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x93, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xee, 0x01,
+	0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x77, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x4c,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x63, 0x0a, 0x0a,
+	0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x47, 0x75, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd5, 0x01, 0x0a, 0x13,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x22, 0xaa, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x57, 0x61,
+	0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x64, 0x22, 0x8f, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x84, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x12, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x6d, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x67, 0x75, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x77, 0x61, 0x69,
+	0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x7a, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x99, 0x01, 0x0a,
+	0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x8e, 0x02, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x77, 0x69, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x5f,
+	0x74, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x47, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x54, 0x6f, 0x57, 0x69,
+	0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x62, 0x65, 0x73, 0x74, 0x47, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x54, 0x6f, 0x57, 0x69, 0x6e,
+	0x22, 0x94, 0x01, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0xd7, 0x02, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x43, 0x0a,
+	0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x47, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x0d, 0x47,
+	0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x65, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x22,
+	0xf4, 0x04, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x48,
+	0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x67,
+	0x75, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0d, 0x67, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x74,
+	0x12, 0x4e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x48, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x16, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x35, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x99, 0x01,
+	0x0a, 0x0d, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x0b, 0x47, 0x75,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x0b, 0x74, 0x72,
+	0x75, 0x65, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04,
+	0x72, 0x75, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x67,
+	0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2a, 0x36,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x55,
+	0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c,
+	0x41, 0x59, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x46, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63,
+	0x74, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49,
+	0x47, 0x48, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x9f,
+	0x01, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x47, 0x55, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f,
+	0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50,
+	0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x55, 0x45, 0x53, 0x53, 0x5f, 0x4f, 0x55,
+	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x05,
+	0x2a, 0x54, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x32, 0x58, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01,
+	0x32, 0x8b, 0x06, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x6e, 0x64,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1a, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x03,
+	0x5a, 0x01, 0x2e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -671,41 +3724,143 @@ func file_proto_experiment_proto_rawDescGZIP() []byte {
 	return file_proto_experiment_proto_rawDescData
 }
 
-var file_proto_experiment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_experiment_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_experiment_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_proto_experiment_proto_goTypes = []any{
-	(*StartRequest)(nil),         // 0: experiment.StartRequest
-	(*StartResponse)(nil),        // 1: experiment.StartResponse
-	(*EndRequest)(nil),           // 2: experiment.EndRequest
-	(*EndResponse)(nil),          // 3: experiment.EndResponse
-	(*ClientMessage)(nil),        // 4: experiment.ClientMessage
-	(*ServerMessage)(nil),        // 5: experiment.ServerMessage
-	(*SendResponseRequest)(nil),  // 6: experiment.SendResponseRequest
-	(*SendResponseResponse)(nil), // 7: experiment.SendResponseResponse
-	(*WaitingListRequest)(nil),   // 8: experiment.WaitingListRequest
-	(*WaitingListResponse)(nil),  // 9: experiment.WaitingListResponse
-	(*LeaderboardRequest)(nil),   // 10: experiment.LeaderboardRequest
-	(*LeaderboardEntry)(nil),     // 11: experiment.LeaderboardEntry
-	(*LeaderboardResponse)(nil),  // 12: experiment.LeaderboardResponse
+	(ResponseMode)(0),             // 0: experiment.ResponseMode
+	(Verdict)(0),                  // 1: experiment.Verdict
+	(ErrorCode)(0),                // 2: experiment.ErrorCode
+	(ConnectionState)(0),          // 3: experiment.ConnectionState
+	(*StartRequest)(nil),          // 4: experiment.StartRequest
+	(*StartResponse)(nil),         // 5: experiment.StartResponse
+	(*EndRequest)(nil),            // 6: experiment.EndRequest
+	(*EndResponse)(nil),           // 7: experiment.EndResponse
+	(*ClientMessage)(nil),         // 8: experiment.ClientMessage
+	(*ServerMessage)(nil),         // 9: experiment.ServerMessage
+	(*Joined)(nil),                // 10: experiment.Joined
+	(*ExperimentStarted)(nil),     // 11: experiment.ExperimentStarted
+	(*TimeRemaining)(nil),         // 12: experiment.TimeRemaining
+	(*GuessAccepted)(nil),         // 13: experiment.GuessAccepted
+	(*GuessResult)(nil),           // 14: experiment.GuessResult
+	(*ExperimentEnded)(nil),       // 15: experiment.ExperimentEnded
+	(*ParticipantResult)(nil),     // 16: experiment.ParticipantResult
+	(*Error)(nil),                 // 17: experiment.Error
+	(*Eliminated)(nil),            // 18: experiment.Eliminated
+	(*ServerShutdown)(nil),        // 19: experiment.ServerShutdown
+	(*SendResponseRequest)(nil),   // 20: experiment.SendResponseRequest
+	(*SendResponseResponse)(nil),  // 21: experiment.SendResponseResponse
+	(*SendResponsesRequest)(nil),  // 22: experiment.SendResponsesRequest
+	(*ResponseOutcome)(nil),       // 23: experiment.ResponseOutcome
+	(*SendResponsesResponse)(nil), // 24: experiment.SendResponsesResponse
+	(*WaitingListRequest)(nil),    // 25: experiment.WaitingListRequest
+	(*WaitingEntry)(nil),          // 26: experiment.WaitingEntry
+	(*WaitingListResponse)(nil),   // 27: experiment.WaitingListResponse
+	(*ClaimRequest)(nil),          // 28: experiment.ClaimRequest
+	(*ClaimResponse)(nil),         // 29: experiment.ClaimResponse
+	(*LeaderboardRequest)(nil),    // 30: experiment.LeaderboardRequest
+	(*LeaderboardEntry)(nil),      // 31: experiment.LeaderboardEntry
+	(*LeaderboardResponse)(nil),   // 32: experiment.LeaderboardResponse
+	(*ListClientsRequest)(nil),    // 33: experiment.ListClientsRequest
+	(*ClientInfo)(nil),            // 34: experiment.ClientInfo
+	(*ListClientsResponse)(nil),   // 35: experiment.ListClientsResponse
+	(*WatchEventsRequest)(nil),    // 36: experiment.WatchEventsRequest
+	(*ClientConnected)(nil),       // 37: experiment.ClientConnected
+	(*ClientDisconnected)(nil),    // 38: experiment.ClientDisconnected
+	(*GuessReceived)(nil),         // 39: experiment.GuessReceived
+	(*ResponseSent)(nil),          // 40: experiment.ResponseSent
+	(*Event)(nil),                 // 41: experiment.Event
+	(*HistoryRequest)(nil),        // 42: experiment.HistoryRequest
+	(*ExperimentRun)(nil),         // 43: experiment.ExperimentRun
+	(*GuessRecord)(nil),           // 44: experiment.GuessRecord
+	(*HistoryResponse)(nil),       // 45: experiment.HistoryResponse
+	(*durationpb.Duration)(nil),   // 46: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 47: google.protobuf.Timestamp
 }
 var file_proto_experiment_proto_depIdxs = []int32{
-	11, // 0: experiment.LeaderboardResponse.entries:type_name -> experiment.LeaderboardEntry
-	4,  // 1: experiment.ExperimentService.Connect:input_type -> experiment.ClientMessage
-	0,  // 2: experiment.ExperimentService.StartExperiment:input_type -> experiment.StartRequest
-	2,  // 3: experiment.ExperimentService.EndExperiment:input_type -> experiment.EndRequest
-	6,  // 4: experiment.ExperimentService.SendResponse:input_type -> experiment.SendResponseRequest
-	8,  // 5: experiment.ExperimentService.WaitingList:input_type -> experiment.WaitingListRequest
-	10, // 6: experiment.ExperimentService.Leaderboard:input_type -> experiment.LeaderboardRequest
-	5,  // 7: experiment.ExperimentService.Connect:output_type -> experiment.ServerMessage
-	1,  // 8: experiment.ExperimentService.StartExperiment:output_type -> experiment.StartResponse
-	3,  // 9: experiment.ExperimentService.EndExperiment:output_type -> experiment.EndResponse
-	7,  // 10: experiment.ExperimentService.SendResponse:output_type -> experiment.SendResponseResponse
-	9,  // 11: experiment.ExperimentService.WaitingList:output_type -> experiment.WaitingListResponse
-	12, // 12: experiment.ExperimentService.Leaderboard:output_type -> experiment.LeaderboardResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	0,  // 0: experiment.StartRequest.response_mode:type_name -> experiment.ResponseMode
+	46, // 1: experiment.StartRequest.duration:type_name -> google.protobuf.Duration
+	15, // 2: experiment.EndResponse.result:type_name -> experiment.ExperimentEnded
+	11, // 3: experiment.ServerMessage.experiment_started:type_name -> experiment.ExperimentStarted
+	14, // 4: experiment.ServerMessage.guess_result:type_name -> experiment.GuessResult
+	15, // 5: experiment.ServerMessage.experiment_ended:type_name -> experiment.ExperimentEnded
+	17, // 6: experiment.ServerMessage.error:type_name -> experiment.Error
+	10, // 7: experiment.ServerMessage.joined:type_name -> experiment.Joined
+	12, // 8: experiment.ServerMessage.time_remaining:type_name -> experiment.TimeRemaining
+	18, // 9: experiment.ServerMessage.eliminated:type_name -> experiment.Eliminated
+	19, // 10: experiment.ServerMessage.server_shutdown:type_name -> experiment.ServerShutdown
+	13, // 11: experiment.ServerMessage.guess_accepted:type_name -> experiment.GuessAccepted
+	47, // 12: experiment.ExperimentStarted.deadline:type_name -> google.protobuf.Timestamp
+	46, // 13: experiment.TimeRemaining.remaining:type_name -> google.protobuf.Duration
+	47, // 14: experiment.TimeRemaining.deadline:type_name -> google.protobuf.Timestamp
+	1,  // 15: experiment.GuessResult.verdict:type_name -> experiment.Verdict
+	47, // 16: experiment.ExperimentEnded.started_at:type_name -> google.protobuf.Timestamp
+	47, // 17: experiment.ExperimentEnded.ended_at:type_name -> google.protobuf.Timestamp
+	16, // 18: experiment.ExperimentEnded.results:type_name -> experiment.ParticipantResult
+	46, // 19: experiment.ParticipantResult.time_to_solve:type_name -> google.protobuf.Duration
+	2,  // 20: experiment.Error.code:type_name -> experiment.ErrorCode
+	1,  // 21: experiment.SendResponseRequest.verdict:type_name -> experiment.Verdict
+	46, // 22: experiment.SendResponsesRequest.min_wait:type_name -> google.protobuf.Duration
+	14, // 23: experiment.ResponseOutcome.result:type_name -> experiment.GuessResult
+	23, // 24: experiment.SendResponsesResponse.outcomes:type_name -> experiment.ResponseOutcome
+	46, // 25: experiment.WaitingListRequest.min_wait:type_name -> google.protobuf.Duration
+	47, // 26: experiment.WaitingEntry.received_at:type_name -> google.protobuf.Timestamp
+	46, // 27: experiment.WaitingEntry.wait:type_name -> google.protobuf.Duration
+	47, // 28: experiment.WaitingEntry.claimed_until:type_name -> google.protobuf.Timestamp
+	26, // 29: experiment.WaitingListResponse.entries:type_name -> experiment.WaitingEntry
+	46, // 30: experiment.ClaimRequest.lease:type_name -> google.protobuf.Duration
+	47, // 31: experiment.ClaimResponse.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 32: experiment.ClaimResponse.entries:type_name -> experiment.WaitingEntry
+	31, // 33: experiment.LeaderboardResponse.entries:type_name -> experiment.LeaderboardEntry
+	3,  // 34: experiment.ClientInfo.state:type_name -> experiment.ConnectionState
+	47, // 35: experiment.ClientInfo.connected_at:type_name -> google.protobuf.Timestamp
+	47, // 36: experiment.ClientInfo.last_seen:type_name -> google.protobuf.Timestamp
+	47, // 37: experiment.ClientInfo.disconnected_at:type_name -> google.protobuf.Timestamp
+	34, // 38: experiment.ListClientsResponse.clients:type_name -> experiment.ClientInfo
+	14, // 39: experiment.ResponseSent.result:type_name -> experiment.GuessResult
+	1,  // 40: experiment.ResponseSent.true_verdict:type_name -> experiment.Verdict
+	47, // 41: experiment.Event.time:type_name -> google.protobuf.Timestamp
+	37, // 42: experiment.Event.client_connected:type_name -> experiment.ClientConnected
+	38, // 43: experiment.Event.client_disconnected:type_name -> experiment.ClientDisconnected
+	39, // 44: experiment.Event.guess_received:type_name -> experiment.GuessReceived
+	40, // 45: experiment.Event.response_sent:type_name -> experiment.ResponseSent
+	11, // 46: experiment.Event.experiment_started:type_name -> experiment.ExperimentStarted
+	15, // 47: experiment.Event.experiment_ended:type_name -> experiment.ExperimentEnded
+	18, // 48: experiment.Event.participant_eliminated:type_name -> experiment.Eliminated
+	47, // 49: experiment.ExperimentRun.started_at:type_name -> google.protobuf.Timestamp
+	47, // 50: experiment.ExperimentRun.ended_at:type_name -> google.protobuf.Timestamp
+	47, // 51: experiment.GuessRecord.received_at:type_name -> google.protobuf.Timestamp
+	1,  // 52: experiment.GuessRecord.verdict:type_name -> experiment.Verdict
+	47, // 53: experiment.GuessRecord.responded_at:type_name -> google.protobuf.Timestamp
+	1,  // 54: experiment.GuessRecord.true_verdict:type_name -> experiment.Verdict
+	43, // 55: experiment.HistoryResponse.runs:type_name -> experiment.ExperimentRun
+	44, // 56: experiment.HistoryResponse.guesses:type_name -> experiment.GuessRecord
+	8,  // 57: experiment.ExperimentService.Connect:input_type -> experiment.ClientMessage
+	4,  // 58: experiment.AdminService.StartExperiment:input_type -> experiment.StartRequest
+	6,  // 59: experiment.AdminService.EndExperiment:input_type -> experiment.EndRequest
+	20, // 60: experiment.AdminService.SendResponse:input_type -> experiment.SendResponseRequest
+	22, // 61: experiment.AdminService.SendResponses:input_type -> experiment.SendResponsesRequest
+	25, // 62: experiment.AdminService.WaitingList:input_type -> experiment.WaitingListRequest
+	30, // 63: experiment.AdminService.Leaderboard:input_type -> experiment.LeaderboardRequest
+	33, // 64: experiment.AdminService.ListClients:input_type -> experiment.ListClientsRequest
+	36, // 65: experiment.AdminService.WatchEvents:input_type -> experiment.WatchEventsRequest
+	42, // 66: experiment.AdminService.GetExperimentHistory:input_type -> experiment.HistoryRequest
+	28, // 67: experiment.AdminService.ClaimPending:input_type -> experiment.ClaimRequest
+	9,  // 68: experiment.ExperimentService.Connect:output_type -> experiment.ServerMessage
+	5,  // 69: experiment.AdminService.StartExperiment:output_type -> experiment.StartResponse
+	7,  // 70: experiment.AdminService.EndExperiment:output_type -> experiment.EndResponse
+	21, // 71: experiment.AdminService.SendResponse:output_type -> experiment.SendResponseResponse
+	24, // 72: experiment.AdminService.SendResponses:output_type -> experiment.SendResponsesResponse
+	27, // 73: experiment.AdminService.WaitingList:output_type -> experiment.WaitingListResponse
+	32, // 74: experiment.AdminService.Leaderboard:output_type -> experiment.LeaderboardResponse
+	35, // 75: experiment.AdminService.ListClients:output_type -> experiment.ListClientsResponse
+	41, // 76: experiment.AdminService.WatchEvents:output_type -> experiment.Event
+	45, // 77: experiment.AdminService.GetExperimentHistory:output_type -> experiment.HistoryResponse
+	29, // 78: experiment.AdminService.ClaimPending:output_type -> experiment.ClaimResponse
+	68, // [68:79] is the sub-list for method output_type
+	57, // [57:68] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_proto_experiment_proto_init() }
@@ -713,18 +3868,40 @@ func file_proto_experiment_proto_init() {
 	if File_proto_experiment_proto != nil {
 		return
 	}
+	file_proto_experiment_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_experiment_proto_msgTypes[5].OneofWrappers = []any{
+		(*ServerMessage_ExperimentStarted)(nil),
+		(*ServerMessage_GuessResult)(nil),
+		(*ServerMessage_ExperimentEnded)(nil),
+		(*ServerMessage_Error)(nil),
+		(*ServerMessage_Joined)(nil),
+		(*ServerMessage_TimeRemaining)(nil),
+		(*ServerMessage_Eliminated)(nil),
+		(*ServerMessage_ServerShutdown)(nil),
+		(*ServerMessage_GuessAccepted)(nil),
+	}
+	file_proto_experiment_proto_msgTypes[37].OneofWrappers = []any{
+		(*Event_ClientConnected)(nil),
+		(*Event_ClientDisconnected)(nil),
+		(*Event_GuessReceived)(nil),
+		(*Event_ResponseSent)(nil),
+		(*Event_ExperimentStarted)(nil),
+		(*Event_ExperimentEnded)(nil),
+		(*Event_ParticipantEliminated)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_experiment_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      4,
+			NumMessages:   42,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_experiment_proto_goTypes,
 		DependencyIndexes: file_proto_experiment_proto_depIdxs,
+		EnumInfos:         file_proto_experiment_proto_enumTypes,
 		MessageInfos:      file_proto_experiment_proto_msgTypes,
 	}.Build()
 	File_proto_experiment_proto = out.File
@@ -19,24 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExperimentService_Connect_FullMethodName         = "/experiment.ExperimentService/Connect"
-	ExperimentService_StartExperiment_FullMethodName = "/experiment.ExperimentService/StartExperiment"
-	ExperimentService_EndExperiment_FullMethodName   = "/experiment.ExperimentService/EndExperiment"
-	ExperimentService_SendResponse_FullMethodName    = "/experiment.ExperimentService/SendResponse"
-	ExperimentService_WaitingList_FullMethodName     = "/experiment.ExperimentService/WaitingList"
-	ExperimentService_Leaderboard_FullMethodName     = "/experiment.ExperimentService/Leaderboard"
+	ExperimentService_Connect_FullMethodName = "/experiment.ExperimentService/Connect"
 )
 
 // ExperimentServiceClient is the client API for ExperimentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Participant API
 type ExperimentServiceClient interface {
 	Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ClientMessage, ServerMessage], error)
-	StartExperiment(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
-	EndExperiment(ctx context.Context, in *EndRequest, opts ...grpc.CallOption) (*EndResponse, error)
-	SendResponse(ctx context.Context, in *SendResponseRequest, opts ...grpc.CallOption) (*SendResponseResponse, error)
-	WaitingList(ctx context.Context, in *WaitingListRequest, opts ...grpc.CallOption) (*WaitingListResponse, error)
-	Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
 }
 
 type experimentServiceClient struct {
@@ -60,246 +52,519 @@ func (c *experimentServiceClient) Connect(ctx context.Context, opts ...grpc.Call
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExperimentService_ConnectClient = grpc.BidiStreamingClient[ClientMessage, ServerMessage]
 
-func (c *experimentServiceClient) StartExperiment(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
+// ExperimentServiceServer is the server API for ExperimentService service.
+// All implementations must embed UnimplementedExperimentServiceServer
+// for forward compatibility.
+//
+// Participant API
+type ExperimentServiceServer interface {
+	Connect(grpc.BidiStreamingServer[ClientMessage, ServerMessage]) error
+	mustEmbedUnimplementedExperimentServiceServer()
+}
+
+// UnimplementedExperimentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExperimentServiceServer struct{}
+
+func (UnimplementedExperimentServiceServer) Connect(grpc.BidiStreamingServer[ClientMessage, ServerMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedExperimentServiceServer) mustEmbedUnimplementedExperimentServiceServer() {}
+func (UnimplementedExperimentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeExperimentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExperimentServiceServer will
+// result in compilation errors.
+type UnsafeExperimentServiceServer interface {
+	mustEmbedUnimplementedExperimentServiceServer()
+}
+
+func RegisterExperimentServiceServer(s grpc.ServiceRegistrar, srv ExperimentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExperimentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExperimentService_ServiceDesc, srv)
+}
+
+func _ExperimentService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExperimentServiceServer).Connect(&grpc.GenericServerStream[ClientMessage, ServerMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExperimentService_ConnectServer = grpc.BidiStreamingServer[ClientMessage, ServerMessage]
+
+// ExperimentService_ServiceDesc is the grpc.ServiceDesc for ExperimentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExperimentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "experiment.ExperimentService",
+	HandlerType: (*ExperimentServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _ExperimentService_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/experiment.proto",
+}
+
+const (
+	AdminService_StartExperiment_FullMethodName      = "/experiment.AdminService/StartExperiment"
+	AdminService_EndExperiment_FullMethodName        = "/experiment.AdminService/EndExperiment"
+	AdminService_SendResponse_FullMethodName         = "/experiment.AdminService/SendResponse"
+	AdminService_SendResponses_FullMethodName        = "/experiment.AdminService/SendResponses"
+	AdminService_WaitingList_FullMethodName          = "/experiment.AdminService/WaitingList"
+	AdminService_Leaderboard_FullMethodName          = "/experiment.AdminService/Leaderboard"
+	AdminService_ListClients_FullMethodName          = "/experiment.AdminService/ListClients"
+	AdminService_WatchEvents_FullMethodName          = "/experiment.AdminService/WatchEvents"
+	AdminService_GetExperimentHistory_FullMethodName = "/experiment.AdminService/GetExperimentHistory"
+	AdminService_ClaimPending_FullMethodName         = "/experiment.AdminService/ClaimPending"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Operator API, requires an admin token in the "authorization" metadata
+type AdminServiceClient interface {
+	StartExperiment(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
+	EndExperiment(ctx context.Context, in *EndRequest, opts ...grpc.CallOption) (*EndResponse, error)
+	SendResponse(ctx context.Context, in *SendResponseRequest, opts ...grpc.CallOption) (*SendResponseResponse, error)
+	SendResponses(ctx context.Context, in *SendResponsesRequest, opts ...grpc.CallOption) (*SendResponsesResponse, error)
+	WaitingList(ctx context.Context, in *WaitingListRequest, opts ...grpc.CallOption) (*WaitingListResponse, error)
+	Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+	ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	GetExperimentHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	ClaimPending(ctx context.Context, in *ClaimRequest, opts ...grpc.CallOption) (*ClaimResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) StartExperiment(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartResponse)
-	err := c.cc.Invoke(ctx, ExperimentService_StartExperiment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AdminService_StartExperiment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *experimentServiceClient) EndExperiment(ctx context.Context, in *EndRequest, opts ...grpc.CallOption) (*EndResponse, error) {
+func (c *adminServiceClient) EndExperiment(ctx context.Context, in *EndRequest, opts ...grpc.CallOption) (*EndResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EndResponse)
-	err := c.cc.Invoke(ctx, ExperimentService_EndExperiment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AdminService_EndExperiment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *experimentServiceClient) SendResponse(ctx context.Context, in *SendResponseRequest, opts ...grpc.CallOption) (*SendResponseResponse, error) {
+func (c *adminServiceClient) SendResponse(ctx context.Context, in *SendResponseRequest, opts ...grpc.CallOption) (*SendResponseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendResponseResponse)
-	err := c.cc.Invoke(ctx, ExperimentService_SendResponse_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AdminService_SendResponse_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *experimentServiceClient) WaitingList(ctx context.Context, in *WaitingListRequest, opts ...grpc.CallOption) (*WaitingListResponse, error) {
+func (c *adminServiceClient) SendResponses(ctx context.Context, in *SendResponsesRequest, opts ...grpc.CallOption) (*SendResponsesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendResponsesResponse)
+	err := c.cc.Invoke(ctx, AdminService_SendResponses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WaitingList(ctx context.Context, in *WaitingListRequest, opts ...grpc.CallOption) (*WaitingListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WaitingListResponse)
-	err := c.cc.Invoke(ctx, ExperimentService_WaitingList_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AdminService_WaitingList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *experimentServiceClient) Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+func (c *adminServiceClient) Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LeaderboardResponse)
-	err := c.cc.Invoke(ctx, ExperimentService_Leaderboard_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AdminService_Leaderboard_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ExperimentServiceServer is the server API for ExperimentService service.
-// All implementations must embed UnimplementedExperimentServiceServer
+func (c *adminServiceClient) ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_WatchEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *adminServiceClient) GetExperimentHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetExperimentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ClaimPending(ctx context.Context, in *ClaimRequest, opts ...grpc.CallOption) (*ClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimResponse)
+	err := c.cc.Invoke(ctx, AdminService_ClaimPending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
-type ExperimentServiceServer interface {
-	Connect(grpc.BidiStreamingServer[ClientMessage, ServerMessage]) error
+//
+// Operator API, requires an admin token in the "authorization" metadata
+type AdminServiceServer interface {
 	StartExperiment(context.Context, *StartRequest) (*StartResponse, error)
 	EndExperiment(context.Context, *EndRequest) (*EndResponse, error)
 	SendResponse(context.Context, *SendResponseRequest) (*SendResponseResponse, error)
+	SendResponses(context.Context, *SendResponsesRequest) (*SendResponsesResponse, error)
 	WaitingList(context.Context, *WaitingListRequest) (*WaitingListResponse, error)
 	Leaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
-	mustEmbedUnimplementedExperimentServiceServer()
+	ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error
+	GetExperimentHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	ClaimPending(context.Context, *ClaimRequest) (*ClaimResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
 }
 
-// UnimplementedExperimentServiceServer must be embedded to have
+// UnimplementedAdminServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedExperimentServiceServer struct{}
+type UnimplementedAdminServiceServer struct{}
 
-func (UnimplementedExperimentServiceServer) Connect(grpc.BidiStreamingServer[ClientMessage, ServerMessage]) error {
-	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
-}
-func (UnimplementedExperimentServiceServer) StartExperiment(context.Context, *StartRequest) (*StartResponse, error) {
+func (UnimplementedAdminServiceServer) StartExperiment(context.Context, *StartRequest) (*StartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartExperiment not implemented")
 }
-func (UnimplementedExperimentServiceServer) EndExperiment(context.Context, *EndRequest) (*EndResponse, error) {
+func (UnimplementedAdminServiceServer) EndExperiment(context.Context, *EndRequest) (*EndResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndExperiment not implemented")
 }
-func (UnimplementedExperimentServiceServer) SendResponse(context.Context, *SendResponseRequest) (*SendResponseResponse, error) {
+func (UnimplementedAdminServiceServer) SendResponse(context.Context, *SendResponseRequest) (*SendResponseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendResponse not implemented")
 }
-func (UnimplementedExperimentServiceServer) WaitingList(context.Context, *WaitingListRequest) (*WaitingListResponse, error) {
+func (UnimplementedAdminServiceServer) SendResponses(context.Context, *SendResponsesRequest) (*SendResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendResponses not implemented")
+}
+func (UnimplementedAdminServiceServer) WaitingList(context.Context, *WaitingListRequest) (*WaitingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitingList not implemented")
 }
-func (UnimplementedExperimentServiceServer) Leaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error) {
+func (UnimplementedAdminServiceServer) Leaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leaderboard not implemented")
 }
-func (UnimplementedExperimentServiceServer) mustEmbedUnimplementedExperimentServiceServer() {}
-func (UnimplementedExperimentServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedAdminServiceServer) ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedAdminServiceServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) GetExperimentHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) ClaimPending(context.Context, *ClaimRequest) (*ClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPending not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeExperimentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ExperimentServiceServer will
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
 // result in compilation errors.
-type UnsafeExperimentServiceServer interface {
-	mustEmbedUnimplementedExperimentServiceServer()
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
 }
 
-func RegisterExperimentServiceServer(s grpc.ServiceRegistrar, srv ExperimentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedExperimentServiceServer was
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ExperimentService_ServiceDesc, srv)
+	s.RegisterService(&AdminService_ServiceDesc, srv)
 }
 
-func _ExperimentService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ExperimentServiceServer).Connect(&grpc.GenericServerStream[ClientMessage, ServerMessage]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ExperimentService_ConnectServer = grpc.BidiStreamingServer[ClientMessage, ServerMessage]
-
-func _ExperimentService_StartExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_StartExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExperimentServiceServer).StartExperiment(ctx, in)
+		return srv.(AdminServiceServer).StartExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExperimentService_StartExperiment_FullMethodName,
+		FullMethod: AdminService_StartExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExperimentServiceServer).StartExperiment(ctx, req.(*StartRequest))
+		return srv.(AdminServiceServer).StartExperiment(ctx, req.(*StartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExperimentService_EndExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_EndExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EndRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExperimentServiceServer).EndExperiment(ctx, in)
+		return srv.(AdminServiceServer).EndExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExperimentService_EndExperiment_FullMethodName,
+		FullMethod: AdminService_EndExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExperimentServiceServer).EndExperiment(ctx, req.(*EndRequest))
+		return srv.(AdminServiceServer).EndExperiment(ctx, req.(*EndRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExperimentService_SendResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_SendResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendResponseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExperimentServiceServer).SendResponse(ctx, in)
+		return srv.(AdminServiceServer).SendResponse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExperimentService_SendResponse_FullMethodName,
+		FullMethod: AdminService_SendResponse_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExperimentServiceServer).SendResponse(ctx, req.(*SendResponseRequest))
+		return srv.(AdminServiceServer).SendResponse(ctx, req.(*SendResponseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExperimentService_WaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_SendResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SendResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SendResponses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SendResponses(ctx, req.(*SendResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WaitingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExperimentServiceServer).WaitingList(ctx, in)
+		return srv.(AdminServiceServer).WaitingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExperimentService_WaitingList_FullMethodName,
+		FullMethod: AdminService_WaitingList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExperimentServiceServer).WaitingList(ctx, req.(*WaitingListRequest))
+		return srv.(AdminServiceServer).WaitingList(ctx, req.(*WaitingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExperimentService_Leaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_Leaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeaderboardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExperimentServiceServer).Leaderboard(ctx, in)
+		return srv.(AdminServiceServer).Leaderboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExperimentService_Leaderboard_FullMethodName,
+		FullMethod: AdminService_Leaderboard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExperimentServiceServer).Leaderboard(ctx, req.(*LeaderboardRequest))
+		return srv.(AdminServiceServer).Leaderboard(ctx, req.(*LeaderboardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ExperimentService_ServiceDesc is the grpc.ServiceDesc for ExperimentService service.
+func _AdminService_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListClients(ctx, req.(*ListClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_WatchEventsServer = grpc.ServerStreamingServer[Event]
+
+func _AdminService_GetExperimentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetExperimentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetExperimentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetExperimentHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ClaimPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ClaimPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ClaimPending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ClaimPending(ctx, req.(*ClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ExperimentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "experiment.ExperimentService",
-	HandlerType: (*ExperimentServiceServer)(nil),
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "experiment.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "StartExperiment",
-			Handler:    _ExperimentService_StartExperiment_Handler,
+			Handler:    _AdminService_StartExperiment_Handler,
 		},
 		{
 			MethodName: "EndExperiment",
-			Handler:    _ExperimentService_EndExperiment_Handler,
+			Handler:    _AdminService_EndExperiment_Handler,
 		},
 		{
 			MethodName: "SendResponse",
-			Handler:    _ExperimentService_SendResponse_Handler,
+			Handler:    _AdminService_SendResponse_Handler,
+		},
+		{
+			MethodName: "SendResponses",
+			Handler:    _AdminService_SendResponses_Handler,
 		},
 		{
 			MethodName: "WaitingList",
-			Handler:    _ExperimentService_WaitingList_Handler,
+			Handler:    _AdminService_WaitingList_Handler,
 		},
 		{
 			MethodName: "Leaderboard",
-			Handler:    _ExperimentService_Leaderboard_Handler,
+			Handler:    _AdminService_Leaderboard_Handler,
+		},
+		{
+			MethodName: "ListClients",
+			Handler:    _AdminService_ListClients_Handler,
+		},
+		{
+			MethodName: "GetExperimentHistory",
+			Handler:    _AdminService_GetExperimentHistory_Handler,
+		},
+		{
+			MethodName: "ClaimPending",
+			Handler:    _AdminService_ClaimPending_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "Connect",
-			Handler:       _ExperimentService_Connect_Handler,
+			StreamName:    "WatchEvents",
+			Handler:       _AdminService_WatchEvents_Handler,
 			ServerStreams: true,
-			ClientStreams: true,
 		},
 	},
 	Metadata: "proto/experiment.proto",
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Environment variable with the admin token, used when no token file is given
+const adminTokenEnv = "HOGWARTS_ADMIN_TOKEN"
+
+// AdminAuth checks the admin token of the requests to AdminService
+type AdminAuth struct {
+	tokens []string
+}
+
+// LoadAdminAuth reads the admin tokens from the file (one per line) or from the environment.
+// If neither is set, a random token is generated
+func LoadAdminAuth(path string) (*AdminAuth, bool, error) {
+	var tokens []string
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read admin token file: %w", err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if token := strings.TrimSpace(line); token != "" {
+				tokens = append(tokens, token)
+			}
+		}
+		if len(tokens) == 0 {
+			return nil, false, fmt.Errorf("admin token file '%s' is empty", path)
+		}
+	} else if token := strings.TrimSpace(os.Getenv(adminTokenEnv)); token != "" {
+		tokens = append(tokens, token)
+	}
+
+	if len(tokens) > 0 {
+		return &AdminAuth{tokens: tokens}, false, nil
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to generate admin token: %w", err)
+	}
+	return &AdminAuth{tokens: []string{token}}, true, nil
+}
+
+// newToken generates a random hex-encoded token
+func newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// authorize checks the token passed in the "authorization" metadata as "Bearer <token>"
+func (a *AdminAuth) authorize(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing admin token")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing admin token")
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+// isAdminMethod reports whether the full method name belongs to AdminService
+func isAdminMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+pb.AdminService_ServiceDesc.ServiceName+"/")
+}
+
+// UnaryInterceptor rejects unauthenticated unary calls to AdminService
+func (a *AdminAuth) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if isAdminMethod(info.FullMethod) {
+		if err := a.authorize(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects unauthenticated streaming calls to AdminService
+func (a *AdminAuth) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isAdminMethod(info.FullMethod) {
+		if err := a.authorize(ss.Context()); err != nil {
+			return err
+		}
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Defaults of ClaimPending
+const (
+	defaultClaimLimit = 10
+	defaultClaimLease = time.Minute
+)
+
+// claim returns the ID of the claim on the guess, or an empty string if it is not claimed or the lease has expired
+func (g *pendingGuess) claim(now time.Time) string {
+	if g.claimID == "" || !now.Before(g.claimedUntil) {
+		return ""
+	}
+	return g.claimID
+}
+
+// ClaimPending reserves the oldest unclaimed guesses of the experiment for the operator, so that several
+// operators do not answer the same guesses
+func (s *Server) ClaimPending(ctx context.Context, req *pb.ClaimRequest) (*pb.ClaimResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit cannot be negative")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultClaimLimit
+	}
+	lease := defaultClaimLease
+	if req.Lease != nil {
+		if err := req.Lease.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid lease: %v", err)
+		}
+		lease = req.Lease.AsDuration()
+		if lease <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "lease must be positive")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp, err := s.getExperiment(req.ExperimentId)
+	if err != nil {
+		return nil, err
+	}
+	// In the other modes the server answers the guesses itself and would answer the claimed ones too
+	if exp.responseMode != pb.ResponseMode_MANUAL {
+		return nil, status.Errorf(codes.FailedPrecondition, "guesses of experiment '%s' are answered %s, claims need MANUAL responses", exp.id, exp.responseMode)
+	}
+
+	// Guesses with expired leases are back in the queue
+	now := time.Now()
+	type candidate struct {
+		username string
+		guess    *pendingGuess
+	}
+	var candidates []candidate
+	for username, queue := range exp.pendingResponses {
+		for i := range queue {
+			if queue[i].claim(now) == "" {
+				candidates = append(candidates, candidate{username, &queue[i]})
+			}
+		}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if c := a.guess.receivedAt.Compare(b.guess.receivedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.guess.id, b.guess.id)
+	})
+
+	resp := &pb.ClaimResponse{Entries: []*pb.WaitingEntry{}}
+	if len(candidates) == 0 {
+		return resp, nil
+	}
+
+	claimID, err := newToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue claim: %v", err)
+	}
+	resp.ClaimId = claimID
+	resp.ExpiresAt = timestamppb.New(now.Add(lease))
+	for _, c := range candidates[:min(limit, len(candidates))] {
+		c.guess.claimID = claimID
+		c.guess.claimedUntil = now.Add(lease)
+		resp.Entries = append(resp.Entries, waitingEntry(exp, c.username, *c.guess, now))
+	}
+
+	log.Printf("Claimed %d guesses in experiment '%s' for %v", len(resp.Entries), exp.id, lease)
+	return resp, nil
+}
+
+// guessToAnswer finds the pending guess of the client that can be answered with the claim: the guess with
+// the given ID, or the oldest one. Guesses claimed by another operator cannot be answered. Must be called with s.mu held
+func (e *Experiment) guessToAnswer(username, guessID, claimID string, now time.Time) (pendingGuess, error) {
+	queue := e.pendingResponses[username]
+	if len(queue) == 0 {
+		return pendingGuess{}, status.Errorf(codes.FailedPrecondition, "no pending response for client '%s'", username)
+	}
+
+	available := func(g pendingGuess) bool { return g.claim(now) == claimID }
+	if guessID == "" && claimID != "" {
+		i := slices.IndexFunc(queue, available)
+		if i < 0 {
+			return pendingGuess{}, status.Errorf(codes.FailedPrecondition, "no pending guess of client '%s' is held by the claim, it may have expired", username)
+		}
+		return queue[i], nil
+	}
+
+	// Without a claim the oldest guess is answered, so that the client gets the responses in order
+	i := 0
+	if guessID != "" {
+		i = slices.IndexFunc(queue, func(g pendingGuess) bool { return g.id == guessID })
+	}
+	switch {
+	case i < 0:
+		return pendingGuess{}, status.Errorf(codes.NotFound, "guess '%s' of client '%s' is not pending", guessID, username)
+	case available(queue[i]):
+		return queue[i], nil
+	case claimID != "":
+		return pendingGuess{}, status.Errorf(codes.FailedPrecondition, "guess '%s' is not held by the claim, it may have expired", guessID)
+	default:
+		return pendingGuess{}, status.Errorf(codes.FailedPrecondition, "guess '%s' is claimed by another operator until %s", queue[i].id, queue[i].claimedUntil.Format(time.RFC3339))
+	}
+}
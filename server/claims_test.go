@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"github.com/Kamil-Jan/hogwarts_experiment/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGuessToAnswer(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	// a is held by claim c1, b was held by c1 but the lease has expired, c is held by c2 and d is not claimed
+	queue := []pendingGuess{
+		{id: "a", claimID: "c1", claimedUntil: now.Add(time.Minute)},
+		{id: "b", claimID: "c1", claimedUntil: now.Add(-time.Second)},
+		{id: "c", claimID: "c2", claimedUntil: now.Add(time.Minute)},
+		{id: "d"},
+	}
+
+	tests := []struct {
+		name     string
+		queue    []pendingGuess
+		guessID  string
+		claimID  string
+		now      time.Time
+		wantID   string
+		wantCode codes.Code
+	}{
+		{name: "oldest held by claim", queue: queue, claimID: "c1", now: now, wantID: "a"},
+		{name: "held by other claim", queue: queue, claimID: "c2", now: now, wantID: "c"},
+		{name: "by ID held by claim", queue: queue, guessID: "c", claimID: "c2", now: now, wantID: "c"},
+		{name: "by ID with expired lease", queue: queue, guessID: "b", now: now, wantID: "b"},
+		{name: "by ID with expired claim", queue: queue, guessID: "b", claimID: "c1", now: now, wantCode: codes.FailedPrecondition},
+		{name: "by ID unclaimed", queue: queue, guessID: "d", now: now, wantID: "d"},
+		{name: "by ID claimed by other", queue: queue, guessID: "a", now: now, wantCode: codes.FailedPrecondition},
+		{name: "by ID with wrong claim", queue: queue, guessID: "a", claimID: "c2", now: now, wantCode: codes.FailedPrecondition},
+		{name: "oldest claimed by other", queue: queue, now: now, wantCode: codes.FailedPrecondition},
+		{name: "oldest after lease expiry", queue: queue, now: now.Add(time.Minute), wantID: "a"},
+		{name: "claim expired", queue: queue, claimID: "c1", now: now.Add(time.Minute), wantCode: codes.FailedPrecondition},
+		{name: "unknown claim", queue: queue, claimID: "c3", now: now, wantCode: codes.FailedPrecondition},
+		{name: "unknown ID", queue: queue, guessID: "z", now: now, wantCode: codes.NotFound},
+		{name: "no pending guesses", now: now, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := NewExperiment("exp")
+			if tt.queue != nil {
+				exp.pendingResponses["harry"] = slices.Clone(tt.queue)
+			}
+
+			guess, err := exp.guessToAnswer("harry", tt.guessID, tt.claimID, tt.now)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("guessToAnswer() error = %v, want code %v", err, tt.wantCode)
+			}
+			if guess.id != tt.wantID {
+				t.Errorf("guessToAnswer() = %q, want %q", guess.id, tt.wantID)
+			}
+			// Finding the guess does not take it from the queue
+			if got := len(exp.pendingResponses["harry"]); got != len(tt.queue) {
+				t.Errorf("queue length = %d, want %d", got, len(tt.queue))
+			}
+		})
+	}
+}
+
+func TestClaimPending(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		mode     pb.ResponseMode
+		limit    int32
+		wantIDs  []string
+		wantCode codes.Code
+	}{
+		{name: "oldest unclaimed first", mode: pb.ResponseMode_MANUAL, wantIDs: []string{"r1", "h1", "h3"}},
+		{name: "limit", mode: pb.ResponseMode_MANUAL, limit: 2, wantIDs: []string{"r1", "h1"}},
+		{name: "automatic responses", mode: pb.ResponseMode_AUTOMATIC, wantCode: codes.FailedPrecondition},
+		{name: "delayed responses", mode: pb.ResponseMode_DELAYED, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewExperimentServer(storage.NewMemoryStore(), 0)
+			exp := s.getOrCreateExperiment("exp")
+			exp.responseMode = tt.mode
+			exp.pendingResponses["harry"] = []pendingGuess{
+				{id: "h1", receivedAt: now.Add(-3 * time.Second)},
+				{id: "h2", receivedAt: now.Add(-2 * time.Second), claimID: "other", claimedUntil: now.Add(time.Minute)},
+				{id: "h3", receivedAt: now.Add(-time.Second)},
+			}
+			exp.pendingResponses["ron"] = []pendingGuess{
+				{id: "r1", receivedAt: now.Add(-4 * time.Second)},
+			}
+
+			resp, err := s.ClaimPending(context.Background(), &pb.ClaimRequest{ExperimentId: "exp", Limit: tt.limit})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("ClaimPending() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+
+			var ids []string
+			for _, entry := range resp.Entries {
+				ids = append(ids, entry.GuessId)
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("claimed %v, want %v", ids, tt.wantIDs)
+			}
+			// The claimed guesses can only be answered with the claim
+			for _, id := range tt.wantIDs {
+				username := "harry"
+				if id == "r1" {
+					username = "ron"
+				}
+				if _, err := exp.guessToAnswer(username, id, resp.ClaimId, time.Now()); err != nil {
+					t.Errorf("guess %s cannot be answered with the claim: %v", id, err)
+				}
+				if _, err := exp.guessToAnswer(username, id, "", time.Now()); status.Code(err) != codes.FailedPrecondition {
+					t.Errorf("guess %s can be answered without the claim: %v", id, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListClients returns the clients of the given experiment, or of all experiments, with their connection state
+func (s *Server) ListClients(ctx context.Context, req *pb.ListClientsRequest) (*pb.ListClientsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	experiments := s.experiments
+	if req.ExperimentId != "" {
+		exp, err := s.getExperiment(req.ExperimentId)
+		if err != nil {
+			return nil, err
+		}
+		experiments = map[string]*Experiment{exp.id: exp}
+	}
+
+	clients := []*pb.ClientInfo{}
+	for _, exp := range experiments {
+		for _, client := range exp.clients {
+			clients = append(clients, clientInfo(exp, client))
+		}
+	}
+
+	return &pb.ListClientsResponse{Clients: clients}, nil
+}
+
+// clientInfo describes the client's connection state. Must be called with s.mu held
+func clientInfo(exp *Experiment, client *Client) *pb.ClientInfo {
+	info := &pb.ClientInfo{
+		Username:     client.username,
+		ExperimentId: exp.id,
+		State:        pb.ConnectionState_CONNECTED,
+		ConnectedAt:  timestamppb.New(client.connectedAt),
+		LastSeen:     timestamppb.New(client.lastSeen),
+		Guesses:      int32(client.guesses),
+	}
+	if !client.connected {
+		info.State = pb.ConnectionState_DISCONNECTED
+		info.DisconnectedAt = timestamppb.New(client.disconnectedAt)
+	}
+	return info
+}
+
+// cleanup periodically removes the clients that have been disconnected for longer than timeout,
+// and the experiments that are not running and have no clients left.
+// The results of the removed clients stay in the participants of the current run
+func (s *Server) cleanup(timeout time.Duration) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		for _, exp := range s.experiments {
+			for username, client := range exp.clients {
+				if !client.connected && time.Since(client.disconnectedAt) > timeout {
+					delete(exp.clients, username)
+					delete(exp.pendingResponses, username)
+					log.Printf("Removed stale client '%s' from experiment '%s'", username, exp.id)
+				}
+			}
+			// The history of the experiment is in the store, it is created again when needed
+			if !exp.active && len(exp.clients) == 0 {
+				delete(s.experiments, exp.id)
+				log.Printf("Removed idle experiment '%s'", exp.id)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
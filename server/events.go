@@ -0,0 +1,97 @@
+package main
+
+import (
+	"log"
+	"sync"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Number of events buffered for a subscriber before new events are dropped
+const eventBufferSize = 256
+
+// Events fans out the server events to the WatchEvents subscribers
+type Events struct {
+	mu          sync.Mutex
+	subscribers map[chan *pb.Event]string // Map of subscriber channels to the watched experiment ID
+	closed      bool                      // Set on shutdown, the channels of the subscribers are closed
+}
+
+func NewEvents() *Events {
+	return &Events{subscribers: make(map[chan *pb.Event]string)}
+}
+
+// subscribe returns a channel receiving the events of the experiment, or of all experiments if experimentID is empty
+func (e *Events) subscribe(experimentID string) chan *pb.Event {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ch := make(chan *pb.Event, eventBufferSize)
+	if e.closed {
+		close(ch)
+		return ch
+	}
+	e.subscribers[ch] = experimentID
+	return ch
+}
+
+func (e *Events) unsubscribe(ch chan *pb.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.subscribers, ch)
+}
+
+// close closes the channels of all the subscribers, so that they stop watching
+func (e *Events) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for ch := range e.subscribers {
+		close(ch)
+	}
+	e.subscribers = make(map[chan *pb.Event]string)
+	e.closed = true
+}
+
+// publish sends the event to the subscribers without blocking. Slow subscribers miss events
+func (e *Events) publish(event *pb.Event) {
+	event.Time = timestamppb.Now()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for ch, experimentID := range e.subscribers {
+		if experimentID != "" && experimentID != event.ExperimentId {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			log.Printf("Dropped event for a slow subscriber")
+		}
+	}
+}
+
+// WatchEvents streams the server events to the operator until the call is cancelled
+func (s *Server) WatchEvents(req *pb.WatchEventsRequest, stream pb.AdminService_WatchEventsServer) error {
+	events := s.events.subscribe(req.ExperimentId)
+	defer s.events.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "server is shutting down")
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+)
+
+const defaultExperimentID = "default"
+
+// Default range of the numbers to guess
+const (
+	defaultMinNumber = 1
+	defaultMaxNumber = 100
+)
+
+// Experiment holds the state of a single guessing session
+type Experiment struct {
+	id               string
+	targetNum        int
+	minNum           int // Lower bound of the number to guess, inclusive
+	maxNum           int // Upper bound of the number to guess, inclusive
+	active           bool
+	startedAt        time.Time
+	deadline         time.Time                 // When the experiment ends automatically, zero if there is no time limit
+	timer            *time.Timer               // Timer of the next time remaining notification or of the deadline
+	responseMode     pb.ResponseMode           // How guesses are answered
+	responseDelay    time.Duration             // Delay before answering a guess in the DELAYED mode
+	maxGuesses       int                       // Number of guesses allowed for each client, no limit if 0
+	clients          map[string]*Client        // Map of usernames to clients participating in the experiment
+	participants     []*Client                 // Clients of the current run, kept for the results even when removed from clients
+	pendingResponses map[string][]pendingGuess // Guesses awaiting responses for each client, the oldest first
+}
+
+// pendingGuess is a guess awaiting a response
+type pendingGuess struct {
+	id           string
+	number       int32
+	attempt      int // Number of the guess among the guesses of the client in the current run
+	receivedAt   time.Time
+	claimID      string    // Claim of the operator answering the guess, see ClaimPending
+	claimedUntil time.Time // When the claim expires and the guess can be claimed again
+}
+
+func NewExperiment(id string) *Experiment {
+	return &Experiment{
+		id:               id,
+		clients:          make(map[string]*Client),
+		pendingResponses: make(map[string][]pendingGuess),
+	}
+}
+
+// hasPendingGuess reports whether the guess of the client is still awaiting a response
+func (e *Experiment) hasPendingGuess(username, guessID string) bool {
+	return slices.ContainsFunc(e.pendingResponses[username], func(g pendingGuess) bool { return g.id == guessID })
+}
+
+// addParticipant adds the client to the current run. A client that took over the username of one who has left
+// replaces it, so that the username has a single result
+func (e *Experiment) addParticipant(client *Client) {
+	i := slices.IndexFunc(e.participants, func(p *Client) bool { return p.username == client.username })
+	if i >= 0 {
+		e.participants[i] = client
+		return
+	}
+	e.participants = append(e.participants, client)
+}
+
+// takePendingGuess removes the guess with the given ID from the queue of the client, or the oldest guess
+// if guessID is empty
+func (e *Experiment) takePendingGuess(username, guessID string) (pendingGuess, bool) {
+	queue := e.pendingResponses[username]
+	i := 0
+	if guessID != "" {
+		i = slices.IndexFunc(queue, func(g pendingGuess) bool { return g.id == guessID })
+	}
+	if i < 0 || i >= len(queue) {
+		return pendingGuess{}, false
+	}
+
+	guess := queue[i]
+	queue = slices.Delete(queue, i, i+1)
+	if len(queue) == 0 {
+		delete(e.pendingResponses, username)
+	} else {
+		e.pendingResponses[username] = queue
+	}
+	return guess, true
+}
+
+// newGuessID generates a random ID for a guess
+func newGuessID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// experimentID returns the ID of the default experiment if none was given
+func experimentID(id string) string {
+	if id == "" {
+		return defaultExperimentID
+	}
+	return id
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+// pendingQueue returns an experiment where harry waits for the responses to the guesses with the given IDs
+func pendingQueue(ids ...string) *Experiment {
+	exp := NewExperiment("exp")
+	for i, id := range ids {
+		exp.pendingResponses["harry"] = append(exp.pendingResponses["harry"], pendingGuess{id: id, number: int32(i), attempt: i + 1})
+	}
+	return exp
+}
+
+func pendingIDs(exp *Experiment, username string) []string {
+	var ids []string
+	for _, guess := range exp.pendingResponses[username] {
+		ids = append(ids, guess.id)
+	}
+	return ids
+}
+
+func TestTakePendingGuess(t *testing.T) {
+	tests := []struct {
+		name      string
+		queue     []string
+		username  string
+		guessID   string
+		wantID    string
+		wantOK    bool
+		wantQueue []string
+	}{
+		{name: "oldest", queue: []string{"a", "b", "c"}, username: "harry", wantID: "a", wantOK: true, wantQueue: []string{"b", "c"}},
+		{name: "by ID in the middle", queue: []string{"a", "b", "c"}, username: "harry", guessID: "b", wantID: "b", wantOK: true, wantQueue: []string{"a", "c"}},
+		{name: "by ID at the end", queue: []string{"a", "b", "c"}, username: "harry", guessID: "c", wantID: "c", wantOK: true, wantQueue: []string{"a", "b"}},
+		{name: "last guess", queue: []string{"a"}, username: "harry", wantID: "a", wantOK: true},
+		{name: "unknown ID", queue: []string{"a", "b"}, username: "harry", guessID: "z", wantQueue: []string{"a", "b"}},
+		{name: "empty queue", username: "harry"},
+		{name: "other client", queue: []string{"a"}, username: "ron", guessID: "a", wantQueue: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := pendingQueue(tt.queue...)
+
+			guess, ok := exp.takePendingGuess(tt.username, tt.guessID)
+			if ok != tt.wantOK || guess.id != tt.wantID {
+				t.Errorf("takePendingGuess() = %q, %v, want %q, %v", guess.id, ok, tt.wantID, tt.wantOK)
+			}
+			if got := pendingIDs(exp, tt.username); !slices.Equal(got, tt.wantQueue) {
+				t.Errorf("queue of %s = %v, want %v", tt.username, got, tt.wantQueue)
+			}
+			// A client without pending guesses has no queue left behind
+			if _, exists := exp.pendingResponses[tt.username]; exists && len(tt.wantQueue) == 0 {
+				t.Errorf("empty queue of %s is kept", tt.username)
+			}
+		})
+	}
+}
+
+func TestTakePendingGuessKeepsOrder(t *testing.T) {
+	exp := pendingQueue("a", "b", "c", "d")
+
+	var taken []string
+	for _, id := range []string{"c", "", "", ""} {
+		guess, ok := exp.takePendingGuess("harry", id)
+		if !ok {
+			t.Fatalf("guess %q was not taken", id)
+		}
+		taken = append(taken, guess.id)
+	}
+	if want := []string{"c", "a", "b", "d"}; !slices.Equal(taken, want) {
+		t.Errorf("taken %v, want %v", taken, want)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"github.com/Kamil-Jan/hogwarts_experiment/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetExperimentHistory returns the finished runs of the experiment and every guess made in it
+func (s *Server) GetExperimentHistory(ctx context.Context, req *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	id := experimentID(req.ExperimentId)
+
+	experiments, err := s.store.Experiments()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load experiments: %v", err)
+	}
+	guesses, err := s.store.Guesses(id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load guesses: %v", err)
+	}
+
+	resp := &pb.HistoryResponse{
+		ExperimentId: id,
+		Runs:         []*pb.ExperimentRun{},
+		Guesses:      []*pb.GuessRecord{},
+	}
+	for _, rec := range experiments {
+		if rec.ID != id {
+			continue
+		}
+		resp.Runs = append(resp.Runs, &pb.ExperimentRun{
+			StartedAt: timestamppb.New(rec.StartedAt),
+			EndedAt:   timestamppb.New(rec.EndedAt),
+			Target:    int32(rec.TargetNum),
+		})
+	}
+	for _, rec := range guesses {
+		resp.Guesses = append(resp.Guesses, guessRecord(rec))
+	}
+
+	if len(resp.Runs) == 0 && len(resp.Guesses) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no history for experiment '%s'", id)
+	}
+	return resp, nil
+}
+
+func guessRecord(rec storage.GuessRecord) *pb.GuessRecord {
+	guess := &pb.GuessRecord{
+		GuessId:    rec.ID,
+		Username:   rec.Username,
+		Guess:      rec.Number,
+		ReceivedAt: timestamppb.New(rec.Time),
+	}
+	if rec.Response != nil {
+		guess.Verdict = pb.Verdict(pb.Verdict_value[rec.Response.Verdict])
+		guess.RespondedAt = timestamppb.New(rec.Response.Time)
+		guess.TrueVerdict = guess.Verdict
+		if rec.Response.Overridden {
+			guess.Overridden = true
+			guess.TrueVerdict = pb.Verdict(pb.Verdict_value[rec.Response.TrueVerdict])
+			guess.Message = rec.Response.Message
+		}
+	}
+	return guess
+}
+
+// writeHistoryCSV writes the guesses of the history as CSV with a header row
+func writeHistoryCSV(w io.Writer, history *pb.HistoryResponse) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"experiment_id", "guess_id", "username", "guess", "received_at", "verdict", "responded_at", "overridden", "true_verdict", "message"})
+	for _, guess := range history.Guesses {
+		verdict, respondedAt, trueVerdict := "", "", ""
+		if guess.RespondedAt != nil {
+			verdict = guess.Verdict.String()
+			respondedAt = formatTime(guess.RespondedAt)
+			trueVerdict = guess.TrueVerdict.String()
+		}
+		writer.Write([]string{
+			history.ExperimentId,
+			guess.GuessId,
+			guess.Username,
+			strconv.Itoa(int(guess.Guess)),
+			formatTime(guess.ReceivedAt),
+			verdict,
+			respondedAt,
+			strconv.FormatBool(guess.Overridden),
+			trueVerdict,
+			guess.Message,
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeHistoryJSONL writes the guesses of the history as JSON Lines, one guess per line
+func writeHistoryJSONL(w io.Writer, history *pb.HistoryResponse) error {
+	for _, guess := range history.Guesses {
+		data, err := jsonMarshaler.Marshal(guess)
+		if err != nil {
+			return fmt.Errorf("failed to encode guess: %w", err)
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatTime(t *timestamppb.Timestamp) string {
+	return t.AsTime().Format(time.RFC3339Nano)
+}
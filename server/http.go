@@ -0,0 +1,449 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	jsonMarshaler   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	jsonUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// Gateway serves the web frontend and the HTTP/JSON API mapped to the gRPC services
+type Gateway struct {
+	server   *Server
+	auth     *AdminAuth
+	mu       sync.Mutex
+	sessions map[string]*webStream // Map of session tokens to the browser sessions
+}
+
+func NewGateway(server *Server, auth *AdminAuth) *Gateway {
+	return &Gateway{
+		server:   server,
+		auth:     auth,
+		sessions: make(map[string]*webStream),
+	}
+}
+
+// Handler returns the HTTP handler serving the API and the frontend
+func (g *Gateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	// Participant API
+	mux.HandleFunc("GET /api/experiments/{id}/connect", g.connect)
+	mux.HandleFunc("POST /api/experiments/{id}/guesses", g.guess)
+
+	// Operator API
+	mux.Handle("POST /api/experiments/{id}/start", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		req := &pb.StartRequest{}
+		if err := decodeBody(r, req); err != nil {
+			return nil, err
+		}
+		req.ExperimentId = r.PathValue("id")
+		return g.server.StartExperiment(ctx, req)
+	}))
+	mux.Handle("POST /api/experiments/{id}/end", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		return g.server.EndExperiment(ctx, &pb.EndRequest{ExperimentId: r.PathValue("id")})
+	}))
+	mux.Handle("POST /api/experiments/{id}/responses", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		req := &pb.SendResponseRequest{}
+		if err := decodeBody(r, req); err != nil {
+			return nil, err
+		}
+		req.ExperimentId = r.PathValue("id")
+		return g.server.SendResponse(ctx, req)
+	}))
+	mux.Handle("POST /api/experiments/{id}/responses/batch", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		req := &pb.SendResponsesRequest{}
+		if err := decodeBody(r, req); err != nil {
+			return nil, err
+		}
+		req.ExperimentId = r.PathValue("id")
+		return g.server.SendResponses(ctx, req)
+	}))
+	mux.Handle("POST /api/experiments/{id}/claims", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		req := &pb.ClaimRequest{}
+		if err := decodeBody(r, req); err != nil {
+			return nil, err
+		}
+		req.ExperimentId = r.PathValue("id")
+		return g.server.ClaimPending(ctx, req)
+	}))
+	mux.Handle("GET /api/experiments/{id}/waiting", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		return g.waitingList(ctx, r, r.PathValue("id"))
+	}))
+	mux.Handle("GET /api/waiting", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		return g.waitingList(ctx, r, r.URL.Query().Get("experiment_id"))
+	}))
+	mux.Handle("GET /api/leaderboard", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		query := r.URL.Query()
+		req := &pb.LeaderboardRequest{
+			ExperimentId: query.Get("experiment_id"),
+			PageToken:    query.Get("page_token"),
+		}
+		if pageSize := query.Get("page_size"); pageSize != "" {
+			n, err := strconv.Atoi(pageSize)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid page size '%s'", pageSize)
+			}
+			req.PageSize = int32(n)
+		}
+		return g.server.Leaderboard(ctx, req)
+	}))
+	mux.Handle("GET /api/clients", g.admin(func(ctx context.Context, r *http.Request) (proto.Message, error) {
+		return g.server.ListClients(ctx, &pb.ListClientsRequest{ExperimentId: r.URL.Query().Get("experiment_id")})
+	}))
+	mux.HandleFunc("GET /api/events", g.watchEvents)
+	mux.HandleFunc("GET /api/experiments/{id}/history", g.history)
+
+	// Frontend
+	mux.Handle("/", http.FileServer(http.FS(webFiles())))
+
+	return mux
+}
+
+// admin wraps an operator API handler with the admin token check and JSON encoding of the response
+func (g *Gateway) admin(handler func(ctx context.Context, r *http.Request) (proto.Message, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := g.authorize(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		resp, err := handler(ctx, r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	})
+}
+
+// waitingList lists the waiting guesses of the experiment, or of all experiments if experimentID is empty
+func (g *Gateway) waitingList(ctx context.Context, r *http.Request, experimentID string) (proto.Message, error) {
+	req := &pb.WaitingListRequest{ExperimentId: experimentID}
+	if minWait := r.URL.Query().Get("min_wait"); minWait != "" {
+		d, err := time.ParseDuration(minWait)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min wait '%s'", minWait)
+		}
+		req.MinWait = durationpb.New(d)
+	}
+	return g.server.WaitingList(ctx, req)
+}
+
+// authorize checks the admin token of the request and returns the context to call the admin RPCs with.
+// The token is passed the same way gRPC clients do, so that the same check applies
+func (g *Gateway) authorize(r *http.Request) (context.Context, error) {
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	if err := g.auth.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// connect joins the experiment and streams the server messages to the browser as server-sent events
+func (g *Gateway) connect(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := startEvents(w)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	stream := newWebStream(r.Context())
+	stream.in <- &pb.ClientMessage{
+		Username:     query.Get("username"),
+		ExperimentId: r.PathValue("id"),
+		SessionToken: query.Get("session_token"),
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- g.server.Connect(stream)
+	}()
+
+	var token string
+	defer func() {
+		if token != "" {
+			g.unregister(token, stream)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-stream.out:
+			if joined := msg.GetJoined(); joined != nil {
+				token = joined.SessionToken
+				g.register(token, stream)
+			}
+			writeEvent(w, "message", msg)
+			flusher.Flush()
+		case err := <-done:
+			// Deliver what the server managed to send before closing the stream
+			for len(stream.out) > 0 {
+				writeEvent(w, "message", <-stream.out)
+			}
+			if err != nil {
+				writeEvent(w, "status", status.Convert(err).Proto())
+			}
+			flusher.Flush()
+			return
+		}
+	}
+}
+
+// watchEvents streams the server events to the operator as server-sent events, like WatchEvents
+func (g *Gateway) watchEvents(w http.ResponseWriter, r *http.Request) {
+	if _, err := g.authorize(r); err != nil {
+		writeError(w, err)
+		return
+	}
+	flusher, ok := startEvents(w)
+	if !ok {
+		return
+	}
+
+	events := g.server.events.subscribe(r.URL.Query().Get("experiment_id"))
+	defer g.server.events.unsubscribe(events)
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				writeEvent(w, "status", status.New(codes.Unavailable, "server is shutting down").Proto())
+				flusher.Flush()
+				return
+			}
+			writeEvent(w, "event", event)
+			flusher.Flush()
+		}
+	}
+}
+
+// history returns the history of the experiment as JSON, or exports its guesses as CSV or JSON Lines
+func (g *Gateway) history(w http.ResponseWriter, r *http.Request) {
+	ctx, err := g.authorize(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	history, err := g.server.GetExperimentHistory(ctx, &pb.HistoryRequest{ExperimentId: r.PathValue("id")})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "", "json":
+		writeJSON(w, http.StatusOK, history)
+		return
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", history.ExperimentId+".csv"))
+		err = writeHistoryCSV(w, history)
+	case "jsonl":
+		w.Header().Set("Content-Type", "application/jsonl")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", history.ExperimentId+".jsonl"))
+		err = writeHistoryJSONL(w, history)
+	default:
+		writeError(w, status.Errorf(codes.InvalidArgument, "unknown format '%s'", format))
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to export history of experiment '%s': %v", history.ExperimentId, err)
+	}
+}
+
+// guess forwards a guess of the browser session to its Connect stream
+func (g *Gateway) guess(w http.ResponseWriter, r *http.Request) {
+	req := &pb.ClientMessage{}
+	if err := decodeBody(r, req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	g.mu.Lock()
+	stream, ok := g.sessions[req.SessionToken]
+	g.mu.Unlock()
+	if !ok {
+		writeError(w, status.Error(codes.NotFound, "session not found"))
+		return
+	}
+
+	select {
+	case stream.in <- &pb.ClientMessage{Number: req.Number}:
+		w.WriteHeader(http.StatusNoContent)
+	case <-stream.ctx.Done():
+		writeError(w, status.Error(codes.Unavailable, "session is closed"))
+	case <-r.Context().Done():
+	}
+}
+
+func (g *Gateway) register(token string, stream *webStream) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.sessions[token] = stream
+}
+
+// unregister forgets the session unless it has already been resumed by another browser stream
+func (g *Gateway) unregister(token string, stream *webStream) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.sessions[token] == stream {
+		delete(g.sessions, token)
+	}
+}
+
+// webStream adapts a browser session to the Connect stream: server messages are sent as events,
+// guesses arrive with separate POST requests
+type webStream struct {
+	ctx context.Context
+	in  chan *pb.ClientMessage
+	out chan *pb.ServerMessage
+}
+
+func newWebStream(ctx context.Context) *webStream {
+	return &webStream{
+		ctx: ctx,
+		in:  make(chan *pb.ClientMessage, 1),
+		out: make(chan *pb.ServerMessage, 64),
+	}
+}
+
+func (s *webStream) Recv() (*pb.ClientMessage, error) {
+	select {
+	case msg := <-s.in:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+func (s *webStream) Send(msg *pb.ServerMessage) error {
+	select {
+	case s.out <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *webStream) Context() context.Context     { return s.ctx }
+func (s *webStream) SetHeader(metadata.MD) error  { return nil }
+func (s *webStream) SendHeader(metadata.MD) error { return nil }
+func (s *webStream) SetTrailer(metadata.MD)       {}
+func (s *webStream) SendMsg(m any) error          { return s.Send(m.(*pb.ServerMessage)) }
+func (s *webStream) RecvMsg(m any) error {
+	msg, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(*pb.ClientMessage), msg)
+	return nil
+}
+
+// decodeBody reads the JSON request body into msg. An empty body is allowed
+func decodeBody(r *http.Request, msg proto.Message) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := jsonUnmarshaler.Unmarshal(body, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, msg proto.Message) {
+	data, err := jsonMarshaler.Marshal(msg)
+	if err != nil {
+		log.Printf("Failed to encode response: %v", err)
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// writeError responds with the gRPC status of the error and the matching HTTP code
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeJSON(w, httpStatus(st.Code()), st.Proto())
+}
+
+// startEvents starts a response of server-sent events
+func startEvents(w http.ResponseWriter) (http.Flusher, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return flusher, true
+}
+
+func writeEvent(w http.ResponseWriter, event string, msg proto.Message) {
+	data, err := jsonMarshaler.Marshal(msg)
+	if err != nil {
+		log.Printf("Failed to encode event: %v", err)
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+}
+
+// httpStatus maps gRPC codes to HTTP status codes
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"github.com/Kamil-Jan/hogwarts_experiment/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Leaderboard returns a page of the ranked leaderboard of the given experiment or the overall one
+func (s *Server) Leaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.LeaderboardResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size cannot be negative")
+	}
+	offset, err := parsePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.store.Leaderboard(req.ExperimentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load leaderboard: %v", err)
+	}
+	ranks := rankPlayers(players)
+
+	end := len(players)
+	if req.PageSize > 0 {
+		end = min(offset+int(req.PageSize), len(players))
+	}
+	offset = min(offset, end)
+
+	resp := &pb.LeaderboardResponse{
+		Entries:   []*pb.LeaderboardEntry{},
+		TotalSize: int32(len(players)),
+	}
+	for i := offset; i < end; i++ {
+		resp.Entries = append(resp.Entries, leaderboardEntry(players[i], ranks[i]))
+	}
+	if end < len(players) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+// rankPlayers sorts the players from the best to the worst and returns their ranks.
+// Players with the same results share the rank, the next rank is skipped then ("1224" ranking)
+func rankPlayers(players []storage.PlayerStats) []int32 {
+	slices.SortFunc(players, func(a, b storage.PlayerStats) int {
+		if c := compareResults(a, b); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Username, b.Username)
+	})
+
+	ranks := make([]int32, len(players))
+	for i := range players {
+		if i > 0 && compareResults(players[i-1], players[i]) == 0 {
+			ranks[i] = ranks[i-1]
+		} else {
+			ranks[i] = int32(i + 1)
+		}
+	}
+	return ranks
+}
+
+// compareResults orders the players by wins, then by the average and the best number of guesses to win
+func compareResults(a, b storage.PlayerStats) int {
+	if c := cmp.Compare(b.Wins, a.Wins); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.AverageGuessesToWin(), b.AverageGuessesToWin()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.BestGuesses, b.BestGuesses)
+}
+
+func leaderboardEntry(stats storage.PlayerStats, rank int32) *pb.LeaderboardEntry {
+	return &pb.LeaderboardEntry{
+		Username:            stats.Username,
+		Wins:                int32(stats.Wins),
+		Rank:                rank,
+		ExperimentsPlayed:   int32(stats.Experiments),
+		TotalGuesses:        int32(stats.Guesses),
+		AverageGuessesToWin: stats.AverageGuessesToWin(),
+		BestGuessesToWin:    int32(stats.BestGuesses),
+	}
+}
+
+// parsePageToken returns the offset of the page. The token is the offset of the first entry
+func parsePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(token)
+	if err != nil || offset < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid page token '%s'", token)
+	}
+	return offset, nil
+}
@@ -2,41 +2,96 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"crypto/subtle"
+	"flag"
 	"io"
 	"log"
 	"math/rand"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
+	"time"
 
 	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"github.com/Kamil-Jan/hogwarts_experiment/storage"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type Client struct {
-	username  string
-	guesses   int
-	lastGuess int32
-	stream    pb.ExperimentService_ConnectServer // Store the stream to send messages to the client
+	username       string
+	sessionToken   string // Token allowing to resume the session under the same username
+	guesses        int
+	lastGuess      int32
+	outbox         *outbox // Queue of the messages to the stream currently serving the client
+	connected      bool
+	connectedAt    time.Time
+	lastSeen       time.Time // Time of the last message received from the client
+	disconnectedAt time.Time
+	solvedAt       time.Time           // When the client guessed the number in the current run, zero if not yet
+	eliminated     bool                // Used all the guesses allowed in the current run without guessing the number
+	overridden     bool                // An operator overrode a response to the client in the current run
+	missed         []*pb.ServerMessage // Messages produced while the client was disconnected
 }
 
+// Maximum number of messages kept for a disconnected client
+const maxMissedMessages = 100
+
 type Server struct {
 	pb.UnimplementedExperimentServiceServer
-	mu               sync.Mutex
-	clients          map[string]*Client // Map of usernames to clients
-	targetNum        int
-	experiment       bool
-	leaderboard      map[string]int
-	pendingResponses map[string]int32 // Store guesses awaiting responses for each client
+	pb.UnimplementedAdminServiceServer
+	mu          sync.Mutex
+	experiments map[string]*Experiment // Map of experiment IDs to experiments
+	store       storage.Store          // Persistent leaderboard, experiment history and guesses
+	events      *Events                // Events for the operators watching the server
+	stopping    bool                   // Set on shutdown, new clients and experiments are rejected
+
+	maxExperiments int // Maximum number of experiments clients can create by connecting, 0 for no limit
 }
 
-func NewExperimentServer() *Server {
+func NewExperimentServer(store storage.Store, maxExperiments int) *Server {
 	return &Server{
-		clients:          make(map[string]*Client),
-		leaderboard:      make(map[string]int),
-		pendingResponses: make(map[string]int32), // Track pending guesses for each client
+		experiments:    make(map[string]*Experiment),
+		store:          store,
+		events:         NewEvents(),
+		maxExperiments: maxExperiments,
+	}
+}
+
+// getExperiment returns the experiment with the given ID. Must be called with s.mu held
+func (s *Server) getExperiment(id string) (*Experiment, error) {
+	exp, ok := s.experiments[experimentID(id)]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "experiment '%s' not found", experimentID(id))
 	}
+	return exp, nil
+}
+
+// getOrCreateExperiment returns the experiment with the given ID, creating it if needed.
+// Must be called with s.mu held
+func (s *Server) getOrCreateExperiment(id string) *Experiment {
+	id = experimentID(id)
+	exp, ok := s.experiments[id]
+	if !ok {
+		exp = NewExperiment(id)
+		s.experiments[id] = exp
+	}
+	return exp
+}
+
+// joinExperiment returns the experiment a client connects to. Unlike the operators, clients are not authenticated,
+// so the number of experiments they can create is limited. Must be called with s.mu held
+func (s *Server) joinExperiment(id string) (*Experiment, error) {
+	if _, ok := s.experiments[experimentID(id)]; !ok && s.maxExperiments > 0 && len(s.experiments) >= s.maxExperiments {
+		return nil, status.Errorf(codes.ResourceExhausted, "experiment '%s' not found and no more experiments can be created, check the experiment ID", experimentID(id))
+	}
+	return s.getOrCreateExperiment(id), nil
 }
 
 // Connect handles bidirectional streaming between the server and client
@@ -51,207 +106,676 @@ func (s *Server) Connect(stream pb.ExperimentService_ConnectServer) error {
 	// Register a new client with the provided username and stream
 	username := clientMsg.Username
 	if username == "" {
-		return fmt.Errorf("username cannot be empty")
+		return status.Error(codes.InvalidArgument, "username cannot be empty")
+	}
+
+	out := newOutbox(stream)
+	defer out.stop(nil)
+
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "server is shutting down")
+	}
+	exp, err := s.joinExperiment(clientMsg.ExperimentId)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	client, resumed, err := s.registerClient(exp, username, clientMsg.SessionToken, out)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+
+	out.push(joinedMessage(exp, client, resumed))
+
+	// Let the client join an experiment that is already running
+	if exp.active {
+		out.push(startedMessage(exp))
+	}
+
+	// Replay the messages produced while the client was away
+	for _, msg := range client.missed {
+		out.push(msg)
+	}
+	client.missed = nil
+	s.mu.Unlock()
+
+	go out.run()
+
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_ClientConnected{
+			ClientConnected: &pb.ClientConnected{Username: username, Resumed: resumed},
+		},
+	})
+
+	if resumed {
+		log.Printf("Client '%s' resumed its session in experiment '%s'", username, exp.id)
+	} else {
+		log.Printf("Client '%s' connected to experiment '%s'", username, exp.id)
+	}
+
+	// Guesses are received in a separate goroutine, so that the stream can be closed
+	// as soon as the outbox is stopped, e.g. when the client is too slow
+	received := make(chan error, 1)
+	go func() {
+		received <- s.receiveGuesses(exp, client, stream, out)
+	}()
+
+	select {
+	case err = <-received:
+		out.stop(err)
+	case <-out.stopped:
+		err = out.err
+		out.logStop(username)
 	}
 
-	client := &Client{username: username, stream: stream}
 	s.mu.Lock()
-	s.clients[username] = client
-	if _, ok := s.leaderboard[username]; !ok {
-		s.leaderboard[username] = 0
+	// The session may have been resumed by another connection, which now owns the client state
+	if client.outbox != out {
+		s.mu.Unlock()
+		return err
 	}
+
+	log.Printf("Client '%s' disconnected from experiment '%s'", username, exp.id)
+
+	// Mark the client as disconnected, it is removed later by cleanup.
+	// Its pending guess and the messages it has not received are kept to be replayed when the session is resumed
+	client.connected = false
+	client.disconnectedAt = time.Now()
+	client.missed = append(out.unsent(), client.missed...)
 	s.mu.Unlock()
 
-	log.Printf("Client '%s' connected", username)
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_ClientDisconnected{
+			ClientDisconnected: &pb.ClientDisconnected{Username: username},
+		},
+	})
+
+	return err
+}
 
-	// Listen for guesses from the client
+// receiveGuesses processes the guesses of the client until the stream is closed
+func (s *Server) receiveGuesses(exp *Experiment, client *Client, stream pb.ExperimentService_ConnectServer, out *outbox) error {
 	for {
 		clientMsg, err := stream.Recv()
 		if err != nil {
-			if err != io.EOF {
-				log.Printf("Error receiving message from client '%s': %v", username, err)
+			if err != io.EOF && status.Code(err) != codes.Canceled {
+				log.Printf("Error receiving message from client '%s': %v", client.username, err)
 			}
-			break
+			return nil
+		}
+
+		// Stop serving the stream once the session has been resumed by another connection
+		if !s.ownsSession(client, out) {
+			return status.Error(codes.Aborted, "session was resumed by another connection")
+		}
+		select {
+		case <-out.stopped:
+			return nil // The stream is being closed by the server
+		default:
 		}
 
 		// Process the client's guess but do not send an immediate response
-		s.processGuess(username, clientMsg.Number)
+		s.processGuess(exp, client.username, clientMsg.Number)
 	}
+}
 
-	log.Printf("Client '%s' disconnected", username)
+// registerClient adds the client to the experiment. A username taken by a connected client can only be
+// reused by presenting the session token issued to its owner. Must be called with s.mu held
+func (s *Server) registerClient(exp *Experiment, username, sessionToken string, out *outbox) (*Client, bool, error) {
+	now := time.Now()
+	if client, ok := exp.clients[username]; ok {
+		validToken := sessionToken != "" && subtle.ConstantTimeCompare([]byte(sessionToken), []byte(client.sessionToken)) == 1
+		if validToken {
+			if client.connected {
+				// The messages the previous connection has not delivered, e.g. because it is dead and the client
+				// does not know it yet, are replayed to the new one
+				client.missed = append(client.outbox.unsent(), client.missed...)
+
+				// Tell the previous connection that it no longer owns the session and close it
+				client.outbox.push(errorMessage(pb.ErrorCode_SESSION_TAKEN_OVER, "Session was resumed by another connection"))
+				client.outbox.close(status.Error(codes.Aborted, "session was resumed by another connection"))
+			}
+			client.outbox = out
+			client.connected = true
+			client.lastSeen = now
+			client.disconnectedAt = time.Time{}
+			return client, true, nil
+		}
+		if client.connected {
+			return nil, false, status.Errorf(codes.AlreadyExists, "username '%s' is already taken in experiment '%s'", username, exp.id)
+		}
+		// The previous owner of the username has left, so it can be taken by a new client
+		delete(exp.pendingResponses, username)
+	}
 
-	// Remove the client after disconnect
+	token, err := newToken()
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to issue session token: %v", err)
+	}
+	client := &Client{
+		username:     username,
+		sessionToken: token,
+		outbox:       out,
+		connected:    true,
+		connectedAt:  now,
+		lastSeen:     now,
+	}
+	exp.clients[username] = client
+	exp.addParticipant(client)
+	if err := s.store.AddParticipant(exp.id, username); err != nil {
+		log.Printf("Failed to add client '%s' to the leaderboard: %v", username, err)
+	}
+	return client, false, nil
+}
+
+// send queues the message for the client, or keeps it to be replayed once the client resumes
+// its session. Must be called with s.mu held
+func (s *Server) send(client *Client, msg *pb.ServerMessage) {
+	if client.connected && client.outbox.push(msg) {
+		return
+	}
+	if len(client.missed) >= maxMissedMessages {
+		client.missed = client.missed[1:]
+	}
+	client.missed = append(client.missed, msg)
+}
+
+// ownsSession reports whether the outbox still belongs to the stream serving the client
+func (s *Server) ownsSession(client *Client, out *outbox) bool {
 	s.mu.Lock()
-	delete(s.pendingResponses, username)
-	s.mu.Unlock()
+	defer s.mu.Unlock()
 
-	return nil
+	return client.outbox == out
 }
 
 // processGuess stores the guess for later response
-func (s *Server) processGuess(username string, guess int32) {
+func (s *Server) processGuess(exp *Experiment, username string, guess int32) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	client, ok := s.clients[username]
+	client, ok := exp.clients[username]
 	if !ok {
-		log.Printf("Client '%s' not found", username)
+		log.Printf("Client '%s' not found in experiment '%s'", username, exp.id)
+		return
+	}
+
+	switch {
+	case !exp.active:
+		s.rejectGuess(exp, client, guess, pb.ErrorCode_EXPERIMENT_NOT_ACTIVE, "The experiment is not running")
+		return
+	case int(guess) < exp.minNum || int(guess) > exp.maxNum:
+		s.rejectGuess(exp, client, guess, pb.ErrorCode_GUESS_OUT_OF_RANGE, "Guess %d is out of range, it must be between %d and %d", guess, exp.minNum, exp.maxNum)
+		return
+	case !client.solvedAt.IsZero():
+		s.rejectGuess(exp, client, guess, pb.ErrorCode_ALREADY_SOLVED, "You have already guessed the number")
+		return
+	case exp.maxGuesses > 0 && client.guesses >= exp.maxGuesses:
+		s.rejectGuess(exp, client, guess, pb.ErrorCode_GUESS_LIMIT_REACHED, "You have used all %d guesses", exp.maxGuesses)
 		return
 	}
 
+	id, err := newGuessID()
+	if err != nil {
+		log.Printf("Failed to generate ID for guess of client '%s': %v", username, err)
+		return
+	}
+
+	now := time.Now()
 	client.guesses++
 	client.lastGuess = guess
+	client.lastSeen = now
+
+	// Queue the guess for a response, the earlier guesses of the client stay pending
+	pending := pendingGuess{id: id, number: guess, attempt: client.guesses, receivedAt: now}
+	exp.pendingResponses[username] = append(exp.pendingResponses[username], pending)
+	s.send(client, guessAcceptedMessage(pending))
+	err = s.store.SaveGuess(storage.GuessRecord{
+		ID:           id,
+		ExperimentID: exp.id,
+		Username:     username,
+		Number:       guess,
+		Time:         now,
+	})
+	if err != nil {
+		log.Printf("Failed to save guess of client '%s': %v", username, err)
+	}
+	log.Printf("Stored guess %d for client '%s' in experiment '%s' (pending response)", guess, username, exp.id)
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_GuessReceived{
+			GuessReceived: &pb.GuessReceived{Username: username, Guess: guess, GuessId: id},
+		},
+	})
+
+	// Answer the guess without an operator if the experiment is configured to
+	switch exp.responseMode {
+	case pb.ResponseMode_AUTOMATIC:
+		if _, err := s.respond(exp, username, id, nil); err != nil {
+			log.Printf("Failed to respond to client '%s' automatically: %v", username, err)
+		}
+	case pb.ResponseMode_DELAYED:
+		startedAt := exp.startedAt
+		time.AfterFunc(exp.responseDelay, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			// The experiment may have been ended or restarted in the meantime
+			if !exp.active || !exp.startedAt.Equal(startedAt) {
+				return
+			}
+			if !exp.hasPendingGuess(username, id) {
+				return // Already answered by an operator
+			}
+			if _, err := s.respond(exp, username, id, nil); err != nil {
+				log.Printf("Failed to respond to client '%s' after delay: %v", username, err)
+			}
+		})
+	}
+}
 
-	// Store the guess in the pending responses map for manual response later
-	s.pendingResponses[username] = guess
-	log.Printf("Stored guess %d for client '%s' (pending response)", guess, username)
+// rejectGuess reports to the client why its guess was not accepted. Must be called with s.mu held
+func (s *Server) rejectGuess(exp *Experiment, client *Client, guess int32, code pb.ErrorCode, format string, args ...any) {
+	message := errorMessage(code, format, args...)
+	s.send(client, message)
+	log.Printf("Rejected guess %d of client '%s' in experiment '%s': %s", guess, client.username, exp.id, message.Message)
 }
 
-// StartExperiment sends a start message to all clients
+// StartExperiment starts the experiment with the given ID and sends a start message to its clients
 func (s *Server) StartExperiment(ctx context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.experiment {
-		return nil, fmt.Errorf("experiment has already started")
+	if s.stopping {
+		return nil, status.Error(codes.Unavailable, "server is shutting down")
+	}
+	if req.ResponseMode == pb.ResponseMode_DELAYED && req.ResponseDelayMs <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "response delay must be positive in the DELAYED mode")
+	}
+	if req.MaxGuesses < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max guesses cannot be negative")
+	}
+	if req.Duration != nil {
+		if err := req.Duration.CheckValid(); err != nil || req.Duration.AsDuration() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "duration must be positive")
+		}
 	}
 
-	// Generate a random number for the experiment
-	s.targetNum = rand.Intn(100) + 1
-	s.experiment = true
-	log.Printf("Experiment started with number: %d", s.targetNum)
+	minNum, maxNum := defaultMinNumber, defaultMaxNumber
+	if req.Min != nil {
+		minNum = int(req.GetMin())
+	}
+	if req.Max != nil {
+		maxNum = int(req.GetMax())
+	}
+	if minNum > maxNum {
+		return nil, status.Errorf(codes.InvalidArgument, "min %d is greater than max %d", minNum, maxNum)
+	}
+	if req.Target != nil && (int(req.GetTarget()) < minNum || int(req.GetTarget()) > maxNum) {
+		return nil, status.Errorf(codes.InvalidArgument, "target %d is out of range [%d, %d]", req.GetTarget(), minNum, maxNum)
+	}
 
-	// Notify all clients about the start of the experiment
-	for _, client := range s.clients {
-		err := client.stream.Send(&pb.ServerMessage{
-			Message: "Experiment started! Guess a number between 1 and 100.",
-		})
-		if err != nil {
-			log.Printf("Error sending start message to client '%s': %v", client.username, err)
+	exp := s.getOrCreateExperiment(req.ExperimentId)
+	if exp.active {
+		return nil, status.Errorf(codes.FailedPrecondition, "experiment '%s' has already started", exp.id)
+	}
+
+	// Use the requested number or generate a random one for the experiment
+	exp.minNum, exp.maxNum = minNum, maxNum
+	if req.Target != nil {
+		exp.targetNum = int(req.GetTarget())
+	} else {
+		exp.targetNum = rand.Intn(maxNum-minNum+1) + minNum
+	}
+	exp.active = true
+	exp.startedAt = time.Now()
+	exp.responseMode = req.ResponseMode
+	exp.responseDelay = time.Duration(req.ResponseDelayMs) * time.Millisecond
+	exp.maxGuesses = int(req.MaxGuesses)
+	if req.Duration != nil {
+		exp.deadline = exp.startedAt.Add(req.Duration.AsDuration())
+		s.scheduleReminder(exp)
+	}
+	log.Printf("Experiment '%s' started with number: %d (%s responses)", exp.id, exp.targetNum, exp.responseMode)
+
+	// Notify all connected clients about the start of the experiment
+	exp.participants = nil
+	for _, client := range exp.clients {
+		exp.participants = append(exp.participants, client)
+
+		// Attempts are counted anew in every run of the experiment
+		client.guesses = 0
+		client.solvedAt = time.Time{}
+		client.eliminated = false
+		client.overridden = false
+		if client.connected {
+			s.send(client, startedMessage(exp))
 		}
 	}
 
-	return &pb.StartResponse{Message: "Experiment started!"}, nil
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_ExperimentStarted{
+			ExperimentStarted: startedMessage(exp).GetExperimentStarted(),
+		},
+	})
+
+	return &pb.StartResponse{Message: "Experiment started!", ExperimentId: exp.id}, nil
 }
 
-// EndExperiment ends the current experiment, notifies all clients, and optionally returns the final leaderboard
+// EndExperiment ends the given experiment and returns the final results, which are also sent to its clients
 func (s *Server) EndExperiment(ctx context.Context, req *pb.EndRequest) (*pb.EndResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Check if there is an active experiment
-	if !s.experiment {
-		return nil, fmt.Errorf("no active experiment to end")
+	exp, err := s.getExperiment(req.ExperimentId)
+	if err != nil {
+		return nil, err
 	}
 
-	// Notify all clients that the experiment is over
-	for _, client := range s.clients {
-		err := client.stream.Send(&pb.ServerMessage{
-			Message: "Experiment ended!",
-		})
-		if err != nil {
-			log.Printf("Failed to notify client '%s' about experiment end: %v", client.username, err)
-		}
+	// Check if the experiment is active
+	if !exp.active {
+		return nil, status.Errorf(codes.FailedPrecondition, "experiment '%s' is not active", exp.id)
 	}
 
-	// Clear experiment state
-	s.experiment = false
-	s.targetNum = 0
-	s.pendingResponses = make(map[string]int32) // Clear pending responses
-	log.Println("Experiment ended.")
+	result := s.endExperiment(exp)
+	return &pb.EndResponse{Message: resultsText(result), Result: result}, nil
+}
+
+// endExperiment ends the active experiment, notifies its clients and saves it. Must be called with s.mu held
+func (s *Server) endExperiment(exp *Experiment) *pb.ExperimentEnded {
+	if exp.timer != nil {
+		exp.timer.Stop()
+		exp.timer = nil
+	}
+
+	// Notify all clients that the experiment is over, without telling them which responses were overridden
+	endedAt := time.Now()
+	ended := endedMessage(exp, endedAt)
+	for _, client := range exp.clients {
+		s.send(client, hideOverrides(ended))
+	}
+
+	result := ended.GetExperimentEnded()
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_ExperimentEnded{
+			ExperimentEnded: result,
+		},
+	})
+
+	// Every participant who made guesses has played this run
+	for _, client := range exp.participants {
+		if client.guesses == 0 {
+			continue
+		}
+		if err := s.store.AddPlay(exp.id, client.username); err != nil {
+			log.Printf("Failed to save play of client '%s': %v", client.username, err)
+		}
+	}
 
-	// Optionally, return the final leaderboard to the admin
-	leaderboardMsg := "Final leaderboard:\n"
-	for username, attempts := range s.leaderboard {
-		leaderboardMsg += fmt.Sprintf("%s: %d attempts\n", username, attempts)
+	err := s.store.SaveExperiment(storage.ExperimentRecord{
+		ID:        exp.id,
+		TargetNum: exp.targetNum,
+		StartedAt: exp.startedAt,
+		EndedAt:   endedAt,
+	})
+	if err != nil {
+		log.Printf("Failed to save experiment '%s': %v", exp.id, err)
 	}
 
-	return &pb.EndResponse{Message: leaderboardMsg}, nil
+	// Clear experiment state
+	exp.active = false
+	exp.targetNum = 0
+	exp.deadline = time.Time{}
+	exp.pendingResponses = make(map[string][]pendingGuess) // Clear pending responses
+	log.Printf("Experiment '%s' ended.", exp.id)
+
+	return result
 }
 
-// SendResponse sends a response for the last guess of a specific client
+// SendResponse sends a response for the oldest pending guess of a specific client, or for the given guess.
+// Guesses claimed by an operator are answered only with their claim
 func (s *Server) SendResponse(ctx context.Context, req *pb.SendResponseRequest) (*pb.SendResponseResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	client, ok := s.clients[req.Username]
-	if !ok {
-		return nil, fmt.Errorf("client '%s' not found", req.Username)
+	exp, err := s.getExperiment(req.ExperimentId)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get the stored guess for the client
-	guess, exists := s.pendingResponses[req.Username]
-	if !exists {
-		return nil, fmt.Errorf("no pending response for client '%s'", req.Username)
+	if _, ok := exp.clients[req.Username]; !ok {
+		return nil, status.Errorf(codes.NotFound, "client '%s' not found in experiment '%s'", req.Username, exp.id)
 	}
-
-	// Process the guess (manual response based on guess)
-	var message string
-	if guess == int32(s.targetNum) {
-		message = "Correct!"
-		s.leaderboard[req.Username] += 1
-	} else if guess < int32(s.targetNum) {
-		message = "Higher!"
-	} else {
-		message = "Lower!"
+	override, err := newResponseOverride(req)
+	if err != nil {
+		return nil, err
 	}
-	delete(s.pendingResponses, req.Username)
-
-	// Send the response to the client
-	err := client.stream.Send(&pb.ServerMessage{Message: message})
+	guess, err := exp.guessToAnswer(req.Username, req.GuessId, req.ClaimId, time.Now())
 	if err != nil {
-		return nil, fmt.Errorf("failed to send message to client '%s': %v", req.Username, err)
+		return nil, err
+	}
+	if _, err := s.respond(exp, req.Username, guess.id, override); err != nil {
+		return nil, err
 	}
 
-	log.Printf("Sent response to client '%s': %s", req.Username, message)
+	return &pb.SendResponseResponse{Message: "Response sent to client", GuessId: guess.id}, nil
+}
 
-	return &pb.SendResponseResponse{Message: "Response sent to client"}, nil
+// responseOverride replaces the true response to a guess
+type responseOverride struct {
+	verdict pb.Verdict // Verdict to send instead of the true one, if set
+	message string     // Text to send instead of the default one for the verdict, if set
 }
 
-// Leaderboard returns the current leaderboard
-func (s *Server) Leaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.LeaderboardResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// newResponseOverride returns the override requested by the operator, or nil if the true response has to be sent
+func newResponseOverride(req *pb.SendResponseRequest) (*responseOverride, error) {
+	if _, ok := verdictMessages[req.Verdict]; !ok && req.Verdict != pb.Verdict_VERDICT_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown verdict %d", req.Verdict)
+	}
+	if req.Verdict == pb.Verdict_VERDICT_UNSPECIFIED && req.Message == "" {
+		return nil, nil
+	}
+	return &responseOverride{verdict: req.Verdict, message: req.Message}, nil
+}
 
-	entries := []*pb.LeaderboardEntry{}
-	for username, wins := range s.leaderboard {
-		entries = append(entries, &pb.LeaderboardEntry{
-			Username: username,
-			Wins:     int32(wins),
-		})
+// respond answers the pending guess of the client with the given ID, or its oldest pending guess if guessID
+// is empty, and returns the result sent to the client. The response can be overridden by the operator.
+// Must be called with s.mu held
+func (s *Server) respond(exp *Experiment, username, guessID string, override *responseOverride) (*pb.GuessResult, error) {
+	client, ok := exp.clients[username]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "client '%s' not found in experiment '%s'", username, exp.id)
 	}
 
-	return &pb.LeaderboardResponse{Entries: entries}, nil
-}
+	// Get the stored guess for the client
+	pending, exists := exp.takePendingGuess(username, guessID)
+	if !exists && guessID != "" {
+		return nil, status.Errorf(codes.NotFound, "guess '%s' of client '%s' is not pending", guessID, username)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.FailedPrecondition, "no pending response for client '%s'", username)
+	}
+	guess := pending.number
+
+	// Process the guess
+	trueVerdict := pb.Verdict_CORRECT
+	if guess < int32(exp.targetNum) {
+		trueVerdict = pb.Verdict_HIGHER
+	} else if guess > int32(exp.targetNum) {
+		trueVerdict = pb.Verdict_LOWER
+	}
 
-// WaitingList returns the list of clients who are waiting for a response
-func (s *Server) WaitingList(ctx context.Context, req *pb.WaitingListRequest) (*pb.WaitingListResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	// The client is treated according to the verdict it receives, even if it is not the true one
+	verdict := trueVerdict
+	if override != nil && override.verdict != pb.Verdict_VERDICT_UNSPECIFIED {
+		verdict = override.verdict
+	}
+	if verdict == pb.Verdict_CORRECT {
+		// The client solved the experiment when the correct guess was received, not when it was answered
+		client.solvedAt = pending.receivedAt
+
+		// The guesses sent after the correct one are not answered and do not count
+		if dropped := len(exp.pendingResponses[username]); dropped > 0 {
+			log.Printf("Dropped %d pending guesses of client '%s' after the correct one", dropped, username)
+		}
+		delete(exp.pendingResponses, username)
+		client.guesses = pending.attempt
 
-	usernames := []string{}
-	for username := range s.pendingResponses {
-		usernames = append(usernames, username)
+		// Only true wins count in the leaderboard, an operator cannot make one up
+		if trueVerdict == pb.Verdict_CORRECT {
+			if err := s.store.AddWin(exp.id, username, client.guesses); err != nil {
+				log.Printf("Failed to save win of client '%s': %v", username, err)
+			}
+		}
 	}
 
-	return &pb.WaitingListResponse{Usernames: usernames}, nil
+	message := guessResultMessage(pending, verdict)
+	if override != nil && override.message != "" {
+		message.Message = override.message
+	}
+
+	// Overrides are kept with the response as the audit trail
+	record := storage.ResponseRecord{
+		GuessID:      pending.id,
+		ExperimentID: exp.id,
+		Verdict:      verdict.String(),
+		Time:         time.Now(),
+	}
+	if override != nil {
+		client.overridden = true
+		record.Overridden = true
+		record.TrueVerdict = trueVerdict.String()
+		record.Message = override.message
+		log.Printf("Response to guess '%s' of client '%s' is overridden: %s (true verdict %s)", pending.id, username, message.Message, trueVerdict)
+	}
+	if err := s.store.SaveResponse(record); err != nil {
+		log.Printf("Failed to save response to client '%s': %v", username, err)
+	}
+
+	// Send the response to the client
+	s.send(client, message)
+	log.Printf("Sent response to client '%s': %s", username, message.Message)
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_ResponseSent{
+			ResponseSent: &pb.ResponseSent{
+				Username:    username,
+				Result:      message.GetGuessResult(),
+				Overridden:  override != nil,
+				TrueVerdict: trueVerdict,
+			},
+		},
+	})
+
+	// All the allowed guesses were answered and none of them was correct
+	if client.solvedAt.IsZero() && exp.maxGuesses > 0 && client.guesses >= exp.maxGuesses && len(exp.pendingResponses[username]) == 0 {
+		s.eliminate(exp, client)
+	}
+	return message.GetGuessResult(), nil
+}
+
+// eliminate marks the client as out of the experiment and notifies it. Must be called with s.mu held
+func (s *Server) eliminate(exp *Experiment, client *Client) {
+	client.eliminated = true
+	message := eliminatedMessage(exp, client)
+	s.send(client, message)
+
+	log.Printf("Client '%s' is eliminated from experiment '%s' after %d guesses", client.username, exp.id, client.guesses)
+	s.events.publish(&pb.Event{
+		ExperimentId: exp.id,
+		Payload: &pb.Event_ParticipantEliminated{
+			ParticipantEliminated: message.GetEliminated(),
+		},
+	})
 }
 
 func main() {
-	grpcServer := grpc.NewServer()
+	storePath := flag.String("store", "hogwarts.jsonl", "Path to the file storing the leaderboard and the history, keep everything in memory if empty")
+	adminTokenFile := flag.String("admin-token-file", "", "Path to the file with admin tokens, one per line (defaults to $"+adminTokenEnv+")")
+	staleClientTimeout := flag.Duration("stale-client-timeout", 10*time.Minute, "How long disconnected clients are kept before being removed")
+	httpAddr := flag.String("http", ":8080", "Address of the HTTP server with the web frontend and the JSON API, disabled if empty")
+	maxExperiments := flag.Int("max-experiments", 100, "Maximum number of experiments clients can create by connecting, no limit if 0")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 10*time.Second, "How long to wait for the calls in progress to finish on shutdown")
+	flag.Parse()
+
+	auth, generated, err := LoadAdminAuth(*adminTokenFile)
+	if err != nil {
+		log.Fatalf("Failed to load admin tokens: %v", err)
+	}
+	if generated {
+		log.Printf("No admin token configured, generated one: %s", auth.tokens[0])
+	}
 
-	server := NewExperimentServer()
+	var store storage.Store = storage.NewMemoryStore()
+	if *storePath != "" {
+		fileStore, err := storage.OpenFileStore(*storePath)
+		if err != nil {
+			log.Fatalf("Failed to open store: %v", err)
+		}
+		store = fileStore
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.UnaryInterceptor),
+		grpc.StreamInterceptor(auth.StreamInterceptor),
+		// Ping idle clients to detect dead connections, e.g. of laptops that went to sleep
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    30 * time.Second,
+			Timeout: 10 * time.Second,
+		}),
+	)
+
+	server := NewExperimentServer(store, *maxExperiments)
+	go server.cleanup(*staleClientTimeout)
 	pb.RegisterExperimentServiceServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, server)
 
 	reflection.Register(grpcServer)
 
+	var httpServer *http.Server
+	if *httpAddr != "" {
+		httpServer = &http.Server{Addr: *httpAddr, Handler: NewGateway(server, auth).Handler()}
+		go func() {
+			log.Printf("HTTP server is listening on %s...", *httpAddr)
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("Failed to serve HTTP server: %v", err)
+			}
+		}()
+	}
+
 	listener, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("Failed to listen on port 50051: %v", err)
 	}
 
-	log.Println("Server is listening on port 50051...")
-	if err := grpcServer.Serve(listener); err != nil {
+	served := make(chan error, 1)
+	go func() {
+		log.Println("Server is listening on port 50051...")
+		served <- grpcServer.Serve(listener)
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case err := <-served:
 		log.Fatalf("Failed to serve gRPC server: %v", err)
+	case <-ctx.Done():
+	}
+	// A second signal kills the server at once
+	stop()
+
+	log.Println("Shutting down...")
+	server.Shutdown()
+	stopServers(grpcServer, httpServer, *shutdownTimeout)
+	if err := store.Close(); err != nil {
+		log.Printf("Failed to close store: %v", err)
 	}
+	log.Println("Server stopped")
 }
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queued returns the messages waiting in the outbox of the client, which is not sending them in the tests
+func queued(client *Client) []*pb.ServerMessage {
+	client.outbox.mu.Lock()
+	defer client.outbox.mu.Unlock()
+
+	return slices.Clone(client.outbox.queue)
+}
+
+// lastResult returns the last guess result sent to the client, or nil if there is none
+func lastResult(client *Client) *pb.ServerMessage {
+	messages := queued(client)
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].GetGuessResult() != nil {
+			return messages[i]
+		}
+	}
+	return nil
+}
+
+func TestNewResponseOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *pb.SendResponseRequest
+		want     *responseOverride
+		wantCode codes.Code
+	}{
+		{name: "true response", req: &pb.SendResponseRequest{}},
+		{name: "verdict", req: &pb.SendResponseRequest{Verdict: pb.Verdict_LOWER}, want: &responseOverride{verdict: pb.Verdict_LOWER}},
+		{name: "message", req: &pb.SendResponseRequest{Message: "Almost"}, want: &responseOverride{message: "Almost"}},
+		{
+			name: "verdict and message",
+			req:  &pb.SendResponseRequest{Verdict: pb.Verdict_CORRECT, Message: "Well done"},
+			want: &responseOverride{verdict: pb.Verdict_CORRECT, message: "Well done"},
+		},
+		{name: "unknown verdict", req: &pb.SendResponseRequest{Verdict: 42}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newResponseOverride(tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("newResponseOverride() error = %v, want code %v", err, tt.wantCode)
+			}
+			if (got == nil) != (tt.want == nil) || got != nil && *got != *tt.want {
+				t.Errorf("newResponseOverride() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendResponseOverride(t *testing.T) {
+	tests := []struct {
+		name        string
+		guess       int32 // The target is 50
+		verdict     pb.Verdict
+		message     string
+		wantVerdict pb.Verdict
+		wantMessage string
+		wantRecord  string // True verdict saved with the response, empty if the response is not overridden
+		wantWon     bool   // Result of the run
+		wantWins    int    // Wins in the leaderboard
+	}{
+		{name: "true correct", guess: 50, wantVerdict: pb.Verdict_CORRECT, wantMessage: "Correct!", wantWon: true, wantWins: 1},
+		{name: "true higher", guess: 10, wantVerdict: pb.Verdict_HIGHER, wantMessage: "Higher!"},
+		{
+			name: "forced correct", guess: 10, verdict: pb.Verdict_CORRECT,
+			wantVerdict: pb.Verdict_CORRECT, wantMessage: "Correct!", wantRecord: "HIGHER", wantWon: true,
+		},
+		{
+			name: "hidden correct", guess: 50, verdict: pb.Verdict_LOWER,
+			wantVerdict: pb.Verdict_LOWER, wantMessage: "Lower!", wantRecord: "CORRECT",
+		},
+		{
+			name: "custom text", guess: 10, message: "A bit more",
+			wantVerdict: pb.Verdict_HIGHER, wantMessage: "A bit more", wantRecord: "HIGHER",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store := newTestServer()
+			harry := join(t, s, "exp", "harry")
+			exp := start(t, s, &pb.StartRequest{})
+			s.processGuess(exp, "harry", tt.guess)
+
+			_, err := s.SendResponse(context.Background(), &pb.SendResponseRequest{
+				ExperimentId: "exp",
+				Username:     "harry",
+				Verdict:      tt.verdict,
+				Message:      tt.message,
+			})
+			if err != nil {
+				t.Fatalf("SendResponse() error = %v", err)
+			}
+
+			// The client gets the overridden response
+			sent := lastResult(harry)
+			if sent == nil || sent.GetGuessResult().Verdict != tt.wantVerdict || sent.Message != tt.wantMessage {
+				t.Errorf("sent %v, want %v %q", sent, tt.wantVerdict, tt.wantMessage)
+			}
+
+			// The response is recorded with the true verdict
+			guesses, err := store.Guesses("exp")
+			if err != nil {
+				t.Fatal(err)
+			}
+			record := guesses[0].Response
+			if record == nil {
+				t.Fatal("response is not recorded")
+			}
+			overridden := tt.wantRecord != ""
+			if record.Verdict != tt.wantVerdict.String() || record.Overridden != overridden || record.TrueVerdict != tt.wantRecord || record.Message != tt.message {
+				t.Errorf("recorded %+v, want verdict %v, true verdict %q, message %q", record, tt.wantVerdict, tt.wantRecord, tt.message)
+			}
+
+			// Only true wins are counted in the leaderboard
+			leaderboard, err := store.Leaderboard("exp")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(leaderboard) != 1 || leaderboard[0].Wins != tt.wantWins {
+				t.Errorf("leaderboard = %+v, want %d wins", leaderboard, tt.wantWins)
+			}
+
+			// The results for the operators mark the overridden ones, the client does not see the mark
+			resp, err := s.EndExperiment(context.Background(), &pb.EndRequest{ExperimentId: "exp"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := resp.Result.Results[0]
+			if result.Won != tt.wantWon || result.Overridden != overridden {
+				t.Errorf("result = %v, want won %v, overridden %v", result, tt.wantWon, overridden)
+			}
+			messages := queued(harry)
+			ended := messages[len(messages)-1].GetExperimentEnded()
+			if ended == nil || ended.Results[0].Won != tt.wantWon || ended.Results[0].Overridden {
+				t.Errorf("client got results %v, want won %v without the override mark", ended, tt.wantWon)
+			}
+		})
+	}
+}
+
+func TestElimination(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           pb.ResponseMode
+		maxGuesses     int32
+		guesses        []int32 // The target is 50
+		responses      int     // Responses sent by the operator in the MANUAL mode
+		wantEliminated bool
+		wantWon        bool
+		wantRejected   int // Guesses rejected for the limit
+	}{
+		{name: "all guesses wrong", mode: pb.ResponseMode_AUTOMATIC, maxGuesses: 2, guesses: []int32{10, 20}, wantEliminated: true},
+		{name: "guess after the limit", mode: pb.ResponseMode_AUTOMATIC, maxGuesses: 2, guesses: []int32{10, 20, 30}, wantEliminated: true, wantRejected: 1},
+		{name: "last guess correct", mode: pb.ResponseMode_AUTOMATIC, maxGuesses: 2, guesses: []int32{10, 50}, wantWon: true},
+		{name: "guesses left", mode: pb.ResponseMode_AUTOMATIC, maxGuesses: 3, guesses: []int32{10, 20}},
+		{name: "no limit", mode: pb.ResponseMode_AUTOMATIC, guesses: []int32{10, 20, 30, 40}},
+		{name: "last guess not answered", mode: pb.ResponseMode_MANUAL, maxGuesses: 2, guesses: []int32{10, 20, 30}, responses: 1, wantRejected: 1},
+		{name: "all guesses answered", mode: pb.ResponseMode_MANUAL, maxGuesses: 2, guesses: []int32{10, 20}, responses: 2, wantEliminated: true},
+		{name: "correct guess pending", mode: pb.ResponseMode_MANUAL, maxGuesses: 2, guesses: []int32{10, 50}, responses: 2, wantWon: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestServer()
+			harry := join(t, s, "exp", "harry")
+			exp := start(t, s, &pb.StartRequest{ResponseMode: tt.mode, MaxGuesses: tt.maxGuesses})
+
+			for _, guess := range tt.guesses {
+				s.processGuess(exp, "harry", guess)
+			}
+			for i := 0; i < tt.responses; i++ {
+				if _, err := s.SendResponse(context.Background(), &pb.SendResponseRequest{ExperimentId: "exp", Username: "harry"}); err != nil {
+					t.Fatalf("SendResponse() error = %v", err)
+				}
+			}
+
+			eliminated, rejected := 0, 0
+			for _, msg := range queued(harry) {
+				if msg.GetEliminated() != nil {
+					eliminated++
+				}
+				if msg.GetError().GetCode() == pb.ErrorCode_GUESS_LIMIT_REACHED {
+					rejected++
+				}
+			}
+			// The client is told once that it is eliminated
+			if (eliminated > 0) != tt.wantEliminated || eliminated > 1 {
+				t.Errorf("got %d eliminated messages, want eliminated %v", eliminated, tt.wantEliminated)
+			}
+			if rejected != tt.wantRejected {
+				t.Errorf("got %d guesses rejected for the limit, want %d", rejected, tt.wantRejected)
+			}
+
+			resp, err := s.EndExperiment(context.Background(), &pb.EndRequest{ExperimentId: "exp"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := resp.Result.Results[0]
+			if result.Eliminated != tt.wantEliminated || result.Won != tt.wantWon {
+				t.Errorf("result = %v, want eliminated %v, won %v", result, tt.wantEliminated, tt.wantWon)
+			}
+		})
+	}
+}
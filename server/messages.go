@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var verdictMessages = map[pb.Verdict]string{
+	pb.Verdict_HIGHER:  "Higher!",
+	pb.Verdict_LOWER:   "Lower!",
+	pb.Verdict_CORRECT: "Correct!",
+}
+
+// startedMessage notifies a client that the experiment has started
+func startedMessage(exp *Experiment) *pb.ServerMessage {
+	message := fmt.Sprintf("Experiment started! Guess a number between %d and %d.", exp.minNum, exp.maxNum)
+	started := &pb.ExperimentStarted{
+		ExperimentId: exp.id,
+		Min:          int32(exp.minNum),
+		Max:          int32(exp.maxNum),
+		MaxGuesses:   int32(exp.maxGuesses),
+	}
+	if exp.maxGuesses > 0 {
+		message += fmt.Sprintf(" You have %d guesses.", exp.maxGuesses)
+	}
+	if !exp.deadline.IsZero() {
+		message += fmt.Sprintf(" You have %v.", time.Until(exp.deadline).Round(time.Second))
+		started.Deadline = timestamppb.New(exp.deadline)
+	}
+	return &pb.ServerMessage{
+		Message: message,
+		Payload: &pb.ServerMessage_ExperimentStarted{
+			ExperimentStarted: started,
+		},
+	}
+}
+
+// timeRemainingMessage reminds a client how much time is left until the experiment ends
+func timeRemainingMessage(exp *Experiment, remaining time.Duration) *pb.ServerMessage {
+	return &pb.ServerMessage{
+		Message: fmt.Sprintf("Time remaining: %v", remaining),
+		Payload: &pb.ServerMessage_TimeRemaining{
+			TimeRemaining: &pb.TimeRemaining{
+				Remaining: durationpb.New(remaining),
+				Deadline:  timestamppb.New(exp.deadline),
+			},
+		},
+	}
+}
+
+// guessAcceptedMessage tells a client the ID of its guess, which is queued for a response
+func guessAcceptedMessage(guess pendingGuess) *pb.ServerMessage {
+	return &pb.ServerMessage{
+		Message: fmt.Sprintf("Guess %d received", guess.number),
+		Payload: &pb.ServerMessage_GuessAccepted{
+			GuessAccepted: &pb.GuessAccepted{
+				GuessId: guess.id,
+				Guess:   guess.number,
+				Attempt: int32(guess.attempt),
+			},
+		},
+	}
+}
+
+// guessResultMessage tells a client how its guess compares to the target number
+func guessResultMessage(guess pendingGuess, verdict pb.Verdict) *pb.ServerMessage {
+	return &pb.ServerMessage{
+		Message: verdictMessages[verdict],
+		Payload: &pb.ServerMessage_GuessResult{
+			GuessResult: &pb.GuessResult{
+				Guess:    guess.number,
+				Verdict:  verdict,
+				Attempts: int32(guess.attempt),
+				GuessId:  guess.id,
+			},
+		},
+	}
+}
+
+// endedMessage notifies a client that the experiment is over and tells the final results
+func endedMessage(exp *Experiment, endedAt time.Time) *pb.ServerMessage {
+	return &pb.ServerMessage{
+		Message: "Experiment ended!",
+		Payload: &pb.ServerMessage_ExperimentEnded{
+			ExperimentEnded: &pb.ExperimentEnded{
+				ExperimentId: exp.id,
+				Target:       int32(exp.targetNum),
+				Summary:      fmt.Sprintf("The number was %d", exp.targetNum),
+				StartedAt:    timestamppb.New(exp.startedAt),
+				EndedAt:      timestamppb.New(endedAt),
+				Results:      experimentResults(exp),
+			},
+		},
+	}
+}
+
+// hideOverrides returns a copy of the ended message without the marks of the overridden results,
+// which are only shown to the operators
+func hideOverrides(msg *pb.ServerMessage) *pb.ServerMessage {
+	msg = proto.Clone(msg).(*pb.ServerMessage)
+	for _, result := range msg.GetExperimentEnded().GetResults() {
+		result.Overridden = false
+	}
+	return msg
+}
+
+// joinedMessage confirms that the client has joined the experiment and hands out its session token
+func joinedMessage(exp *Experiment, client *Client, resumed bool) *pb.ServerMessage {
+	message := fmt.Sprintf("Joined experiment '%s'", exp.id)
+	if resumed {
+		message = fmt.Sprintf("Resumed session in experiment '%s'", exp.id)
+	}
+	if !exp.active {
+		message += ", it is not running yet, waiting for the operator to start it"
+	}
+	return &pb.ServerMessage{
+		Message: message,
+		Payload: &pb.ServerMessage_Joined{
+			Joined: &pb.Joined{
+				ExperimentId: exp.id,
+				SessionToken: client.sessionToken,
+				Resumed:      resumed,
+			},
+		},
+	}
+}
+
+// errorMessage reports a problem to the client
+func errorMessage(code pb.ErrorCode, format string, args ...any) *pb.ServerMessage {
+	message := fmt.Sprintf(format, args...)
+	return &pb.ServerMessage{
+		Message: message,
+		Payload: &pb.ServerMessage_Error{
+			Error: &pb.Error{Message: message, Code: code},
+		},
+	}
+}
+
+// shutdownMessage warns a client that the server is stopping
+func shutdownMessage() *pb.ServerMessage {
+	reason := "Server is shutting down, reconnect once it is back"
+	return &pb.ServerMessage{
+		Message: reason,
+		Payload: &pb.ServerMessage_ServerShutdown{
+			ServerShutdown: &pb.ServerShutdown{Reason: reason},
+		},
+	}
+}
+
+// eliminatedMessage tells a client that it has used all the guesses without guessing the number
+func eliminatedMessage(exp *Experiment, client *Client) *pb.ServerMessage {
+	return &pb.ServerMessage{
+		Message: fmt.Sprintf("You have used all %d guesses and are out of the experiment.", exp.maxGuesses),
+		Payload: &pb.ServerMessage_Eliminated{
+			Eliminated: &pb.Eliminated{
+				Username:   client.username,
+				Guesses:    int32(client.guesses),
+				MaxGuesses: int32(exp.maxGuesses),
+			},
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits after which a client that does not read its messages is disconnected
+const (
+	outboxSize  = 256              // Messages queued for a client stream
+	sendTimeout = 10 * time.Second // Time to send a single message
+)
+
+// outbox queues the messages for a client stream and sends them from a dedicated goroutine,
+// so that a slow client does not block the server
+type outbox struct {
+	stream   pb.ExperimentService_ConnectServer
+	mu       sync.Mutex
+	queue    []*pb.ServerMessage
+	sending  *pb.ServerMessage // Message being sent, kept until the stream accepts it
+	closing  bool              // Set by close, the stream is closed once the queue is empty
+	closeErr error             // Error to close the stream with once the queued messages are sent
+	ready    chan struct{}     // Signals the sender that messages were queued
+	once     sync.Once
+	stopped  chan struct{} // Closed when the stream has to be closed
+	err      error         // Error to close the stream with, set before stopped is closed
+}
+
+func newOutbox(stream pb.ExperimentService_ConnectServer) *outbox {
+	return &outbox{
+		stream:  stream,
+		ready:   make(chan struct{}, 1),
+		stopped: make(chan struct{}),
+	}
+}
+
+// push queues the message without blocking. If the queue is full, the client is considered too slow
+// and its stream is stopped. Returns false if the message was not queued
+func (o *outbox) push(msg *pb.ServerMessage) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.isStopped() || o.closing {
+		return false
+	}
+	if len(o.queue) >= outboxSize {
+		o.stop(status.Error(codes.ResourceExhausted, "client does not keep up with the messages"))
+		return false
+	}
+	o.queue = append(o.queue, msg)
+	o.notify()
+	return true
+}
+
+// close stops the stream with err after the queued messages are sent
+func (o *outbox) close(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.closing = true
+	o.closeErr = err
+	o.notify()
+}
+
+// notify wakes up the sender. Must be called with o.mu held
+func (o *outbox) notify() {
+	select {
+	case o.ready <- struct{}{}:
+	default:
+	}
+}
+
+// stop makes the stream close with err. Only the first call has an effect
+func (o *outbox) stop(err error) {
+	o.once.Do(func() {
+		o.err = err
+		close(o.stopped)
+	})
+}
+
+func (o *outbox) isStopped() bool {
+	select {
+	case <-o.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// next takes the next message to send, or returns nil if the queue is empty
+func (o *outbox) next() *pb.ServerMessage {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if len(o.queue) == 0 {
+		if o.closing {
+			o.stop(o.closeErr)
+		}
+		return nil
+	}
+	o.sending = o.queue[0]
+	o.queue = o.queue[1:]
+	return o.sending
+}
+
+// run sends the queued messages until the outbox is stopped
+func (o *outbox) run() {
+	for !o.isStopped() {
+		msg := o.next()
+		if msg == nil {
+			select {
+			case <-o.stopped:
+			case <-o.ready:
+			}
+			continue
+		}
+
+		// A send blocked by a client that does not read stops the outbox, so that the stream is closed
+		timer := time.AfterFunc(sendTimeout, func() {
+			o.stop(status.Error(codes.DeadlineExceeded, "client does not receive the messages"))
+		})
+		err := o.stream.Send(msg)
+		timer.Stop()
+		if err != nil {
+			o.stop(err)
+			return
+		}
+
+		o.mu.Lock()
+		if o.sending == msg {
+			o.sending = nil
+		}
+		o.mu.Unlock()
+	}
+}
+
+// unsent takes the messages that were queued but not accepted by the stream, including the one being sent,
+// so that they can be replayed to another stream of the client
+func (o *outbox) unsent() []*pb.ServerMessage {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var messages []*pb.ServerMessage
+	if o.sending != nil {
+		messages = append(messages, o.sending)
+		o.sending = nil
+	}
+	messages = append(messages, o.queue...)
+	o.queue = nil
+	return messages
+}
+
+// logStop logs why the stream of the client was stopped by the server
+func (o *outbox) logStop(username string) {
+	if code := status.Code(o.err); code == codes.ResourceExhausted || code == codes.DeadlineExceeded {
+		log.Printf("Disconnecting slow client '%s': %v", username, o.err)
+	}
+}
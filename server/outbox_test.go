@@ -0,0 +1,185 @@
+package main
+
+import (
+	"io"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream records the messages sent to a client. If block is set, Send waits until it is closed
+type fakeStream struct {
+	grpc.ServerStream
+	block   chan struct{}
+	sending chan struct{} // Signaled when Send is called
+
+	mu   sync.Mutex
+	sent []string
+}
+
+func newFakeStream(block bool) *fakeStream {
+	stream := &fakeStream{sending: make(chan struct{}, 1)}
+	if block {
+		stream.block = make(chan struct{})
+	}
+	return stream
+}
+
+func (f *fakeStream) Send(msg *pb.ServerMessage) error {
+	select {
+	case f.sending <- struct{}{}:
+	default:
+	}
+	if f.block != nil {
+		<-f.block
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, msg.Message)
+	return nil
+}
+
+func (f *fakeStream) Recv() (*pb.ClientMessage, error) {
+	return nil, io.EOF
+}
+
+func (f *fakeStream) messages() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.sent)
+}
+
+// texts returns the texts of the messages
+func texts(messages []*pb.ServerMessage) []string {
+	var result []string
+	for _, msg := range messages {
+		result = append(result, msg.Message)
+	}
+	return result
+}
+
+func waitStopped(t *testing.T, out *outbox) {
+	t.Helper()
+
+	select {
+	case <-out.stopped:
+	case <-time.After(time.Second):
+		t.Fatal("outbox was not stopped")
+	}
+}
+
+func TestOutboxPush(t *testing.T) {
+	tests := []struct {
+		name       string
+		pushes     int
+		close      bool // Close the outbox before the last push
+		wantQueued bool // Result of the last push
+		wantCode   codes.Code
+	}{
+		{name: "below limit", pushes: 10, wantQueued: true, wantCode: codes.OK},
+		{name: "full queue", pushes: outboxSize, wantQueued: true, wantCode: codes.OK},
+		{name: "over limit", pushes: outboxSize + 1, wantQueued: false, wantCode: codes.ResourceExhausted},
+		{name: "closing", pushes: 2, close: true, wantQueued: false, wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The outbox is not running, so that nothing is taken from the queue
+			out := newOutbox(newFakeStream(false))
+			for i := 0; i < tt.pushes-1; i++ {
+				if !out.push(&pb.ServerMessage{}) {
+					t.Fatalf("push %d was not queued", i)
+				}
+			}
+			if tt.close {
+				out.close(nil)
+			}
+
+			if got := out.push(&pb.ServerMessage{}); got != tt.wantQueued {
+				t.Errorf("push() = %v, want %v", got, tt.wantQueued)
+			}
+			stopped := tt.wantCode != codes.OK
+			if out.isStopped() != stopped {
+				t.Fatalf("stopped = %v, want %v", out.isStopped(), stopped)
+			}
+			if code := status.Code(out.err); stopped && code != tt.wantCode {
+				t.Errorf("stop code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestOutboxUnsent(t *testing.T) {
+	tests := []struct {
+		name string
+		run  bool // Start sending, the first message gets stuck in a blocked Send
+		want []string
+	}{
+		{name: "not sending", run: false, want: []string{"a", "b", "c"}},
+		{name: "message in flight", run: true, want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := newFakeStream(true)
+			out := newOutbox(stream)
+			for _, text := range []string{"a", "b", "c"} {
+				out.push(&pb.ServerMessage{Message: text})
+			}
+			if tt.run {
+				go out.run()
+				<-stream.sending
+			}
+
+			if got := texts(out.unsent()); !slices.Equal(got, tt.want) {
+				t.Errorf("unsent() = %v, want %v", got, tt.want)
+			}
+			if got := out.unsent(); len(got) != 0 {
+				t.Errorf("second unsent() = %v, want none", texts(got))
+			}
+
+			out.stop(nil)
+			close(stream.block)
+		})
+	}
+}
+
+func TestOutboxClose(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		err      error
+	}{
+		{name: "empty queue", err: status.Error(codes.Aborted, "session taken over")},
+		{name: "queued messages", messages: []string{"a", "b"}, err: status.Error(codes.Unavailable, "shutting down")},
+		{name: "no error", messages: []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := newFakeStream(false)
+			out := newOutbox(stream)
+			for _, text := range tt.messages {
+				out.push(&pb.ServerMessage{Message: text})
+			}
+			out.close(tt.err)
+			go out.run()
+
+			waitStopped(t, out)
+			// The queued messages are sent before the stream is stopped
+			if got := stream.messages(); !slices.Equal(got, tt.messages) {
+				t.Errorf("sent %v, want %v", got, tt.messages)
+			}
+			if out.err != tt.err {
+				t.Errorf("stop error = %v, want %v", out.err, tt.err)
+			}
+		})
+	}
+}
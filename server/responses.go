@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SendResponses answers the waiting guesses of all the clients of the experiment, or of the given clients,
+// in the order they were received. A failure to answer a guess does not stop the others from being answered,
+// but the later guesses of the same client are skipped to keep its responses in order. Every guess is answered
+// like with SendResponse
+func (s *Server) SendResponses(ctx context.Context, req *pb.SendResponsesRequest) (*pb.SendResponsesResponse, error) {
+	var minWait time.Duration
+	if req.MinWait != nil {
+		if err := req.MinWait.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min wait: %v", err)
+		}
+		minWait = req.MinWait.AsDuration()
+		if minWait < 0 {
+			return nil, status.Error(codes.InvalidArgument, "min wait cannot be negative")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp, err := s.getExperiment(req.ExperimentId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SendResponsesResponse{Outcomes: []*pb.ResponseOutcome{}}
+	fail := func(username, guessID string, err error) {
+		resp.Outcomes = append(resp.Outcomes, &pb.ResponseOutcome{
+			Username: username,
+			GuessId:  guessID,
+			Error:    status.Convert(err).Message(),
+		})
+		resp.Failed++
+	}
+
+	// Collect the guesses first, answering a correct guess drops the later guesses of the client
+	now := time.Now()
+	var guesses []*pb.WaitingEntry
+	for username, queue := range exp.pendingResponses {
+		if len(req.Usernames) > 0 && !slices.Contains(req.Usernames, username) {
+			continue
+		}
+		for _, guess := range queue {
+			// With a claim only the guesses held by it are answered, without one only the unclaimed guesses
+			if guess.claim(now) != req.ClaimId {
+				continue
+			}
+			if now.Sub(guess.receivedAt) >= minWait {
+				guesses = append(guesses, waitingEntry(exp, username, guess, now))
+			}
+		}
+	}
+	slices.SortFunc(guesses, compareWaitingEntries)
+
+	// The requested clients without guesses to answer are reported as failures
+	for _, username := range req.Usernames {
+		if _, ok := exp.clients[username]; !ok {
+			fail(username, "", status.Errorf(codes.NotFound, "client '%s' not found in experiment '%s'", username, exp.id))
+		} else if !slices.ContainsFunc(guesses, func(e *pb.WaitingEntry) bool { return e.Username == username }) {
+			fail(username, "", status.Errorf(codes.FailedPrecondition, "no pending response for client '%s'", username))
+		}
+	}
+
+	skipped := make(map[string]bool) // Clients with a guess that was not answered
+	for _, guess := range guesses {
+		if skipped[guess.Username] {
+			fail(guess.Username, guess.GuessId, status.Error(codes.Aborted, "an earlier guess of the client was not answered"))
+			continue
+		}
+		if !exp.hasPendingGuess(guess.Username, guess.GuessId) {
+			fail(guess.Username, guess.GuessId, status.Error(codes.Aborted, "the client has guessed the number with an earlier guess"))
+			continue
+		}
+
+		_, err := exp.guessToAnswer(guess.Username, guess.GuessId, req.ClaimId, now)
+		var result *pb.GuessResult
+		if err == nil {
+			result, err = s.respond(exp, guess.Username, guess.GuessId, nil)
+		}
+		if err != nil {
+			skipped[guess.Username] = true
+			fail(guess.Username, guess.GuessId, err)
+			continue
+		}
+
+		resp.Outcomes = append(resp.Outcomes, &pb.ResponseOutcome{
+			Username: guess.Username,
+			GuessId:  guess.GuessId,
+			Result:   result,
+		})
+		resp.Answered++
+	}
+
+	log.Printf("Sent %d responses in experiment '%s', %d failed", resp.Answered, exp.id, resp.Failed)
+	return resp, nil
+}
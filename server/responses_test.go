@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSendResponses(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *pb.SendResponsesRequest
+		wantAnswered []string // IDs of the answered guesses, in order
+		wantFailed   int32
+	}{
+		{name: "unclaimed guesses", req: &pb.SendResponsesRequest{}, wantAnswered: []string{"m1", "h2"}},
+		{name: "min wait", req: &pb.SendResponsesRequest{MinWait: durationpb.New(30 * time.Second)}, wantAnswered: []string{"m1"}},
+		{name: "own claim", req: &pb.SendResponsesRequest{ClaimId: "mine"}, wantAnswered: []string{"r1"}},
+		{name: "other claim", req: &pb.SendResponsesRequest{ClaimId: "other"}, wantAnswered: []string{"h1"}},
+		{name: "client with claimed guesses only", req: &pb.SendResponsesRequest{Usernames: []string{"ron"}}, wantFailed: 1},
+		{name: "unknown client", req: &pb.SendResponsesRequest{Usernames: []string{"draco", "hermione"}}, wantAnswered: []string{"m1"}, wantFailed: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestServer()
+			for _, username := range []string{"harry", "ron", "hermione"} {
+				join(t, s, "exp", username)
+			}
+			exp := start(t, s, &pb.StartRequest{})
+
+			// h1 is claimed by another operator, r1 by this one, h2 and m1 are not claimed
+			now := time.Now()
+			exp.pendingResponses = map[string][]pendingGuess{
+				"harry": {
+					{id: "h1", number: 10, attempt: 1, receivedAt: now.Add(-3 * time.Minute), claimID: "other", claimedUntil: now.Add(time.Minute)},
+					{id: "h2", number: 20, attempt: 2, receivedAt: now.Add(-10 * time.Second)},
+				},
+				"ron":      {{id: "r1", number: 30, attempt: 1, receivedAt: now.Add(-2 * time.Minute), claimID: "mine", claimedUntil: now.Add(time.Minute)}},
+				"hermione": {{id: "m1", number: 40, attempt: 1, receivedAt: now.Add(-time.Minute)}},
+			}
+
+			tt.req.ExperimentId = "exp"
+			resp, err := s.SendResponses(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("SendResponses() error = %v", err)
+			}
+
+			var answered []string
+			for _, outcome := range resp.Outcomes {
+				if outcome.Result != nil {
+					answered = append(answered, outcome.GuessId)
+				}
+			}
+			if !slices.Equal(answered, tt.wantAnswered) || resp.Answered != int32(len(tt.wantAnswered)) {
+				t.Errorf("answered %v (%d), want %v", answered, resp.Answered, tt.wantAnswered)
+			}
+			if resp.Failed != tt.wantFailed {
+				t.Errorf("failed = %d, want %d: %v", resp.Failed, tt.wantFailed, resp.Outcomes)
+			}
+			for _, id := range []string{"h1", "h2", "r1", "m1"} {
+				pending := false
+				for username := range exp.pendingResponses {
+					pending = pending || exp.hasPendingGuess(username, id)
+				}
+				if pending == slices.Contains(tt.wantAnswered, id) {
+					t.Errorf("guess %s pending = %v after the responses", id, pending)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// experimentResults returns the final ranking of the participants of the current run of the experiment,
+// including the ones removed as stale before the run ended. Must be called with s.mu held
+func experimentResults(exp *Experiment) []*pb.ParticipantResult {
+	results := []*pb.ParticipantResult{}
+	for _, client := range exp.participants {
+		result := &pb.ParticipantResult{
+			Username:   client.username,
+			Guesses:    int32(client.guesses),
+			Won:        !client.solvedAt.IsZero(),
+			Eliminated: client.eliminated,
+			Overridden: client.overridden,
+		}
+		if result.Won {
+			result.TimeToSolve = durationpb.New(client.solvedAt.Sub(exp.startedAt))
+		}
+		results = append(results, result)
+	}
+
+	slices.SortFunc(results, func(a, b *pb.ParticipantResult) int {
+		if c := compareParticipantResults(a, b); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Username, b.Username)
+	})
+	for i, result := range results {
+		if i > 0 && compareParticipantResults(results[i-1], result) == 0 {
+			result.Rank = results[i-1].Rank
+		} else {
+			result.Rank = int32(i + 1)
+		}
+	}
+	return results
+}
+
+// compareParticipantResults puts the winners first, ordered by the number of guesses and then by the time to solve
+func compareParticipantResults(a, b *pb.ParticipantResult) int {
+	if a.Won != b.Won {
+		if a.Won {
+			return -1
+		}
+		return 1
+	}
+	if !a.Won {
+		return 0
+	}
+	if c := cmp.Compare(a.Guesses, b.Guesses); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.TimeToSolve.AsDuration(), b.TimeToSolve.AsDuration())
+}
+
+// resultsText formats the results of the experiment for humans
+func resultsText(ended *pb.ExperimentEnded) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Experiment '%s' ended, the number was %d\n", ended.ExperimentId, ended.Target)
+	for _, result := range ended.Results {
+		if result.Won {
+			fmt.Fprintf(&b, "%d. %s: won in %d guesses (%v)", result.Rank, result.Username, result.Guesses,
+				result.TimeToSolve.AsDuration().Round(time.Millisecond))
+		} else if result.Eliminated {
+			fmt.Fprintf(&b, "%d. %s: eliminated after %d guesses", result.Rank, result.Username, result.Guesses)
+		} else {
+			fmt.Fprintf(&b, "%d. %s: did not win, %d guesses", result.Rank, result.Username, result.Guesses)
+		}
+		if result.Overridden {
+			b.WriteString(", responses overridden by an operator")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"github.com/Kamil-Jan/hogwarts_experiment/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestServer returns a server keeping everything in memory
+func newTestServer() (*Server, *storage.MemoryStore) {
+	store := storage.NewMemoryStore()
+	return NewExperimentServer(store, 0), store
+}
+
+// join connects a new client with the username to the experiment, without a session token
+func join(t *testing.T, s *Server, experimentID, username string) *Client {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp := s.getOrCreateExperiment(experimentID)
+	client, _, err := s.registerClient(exp, username, "", newOutbox(newFakeStream(false)))
+	if err != nil {
+		t.Fatalf("registerClient(%s) error = %v", username, err)
+	}
+	return client
+}
+
+// leave marks the client as disconnected, like Connect does when its stream ends
+func leave(s *Server, client *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client.connected = false
+}
+
+// start starts the experiment with the target 50 and the given settings
+func start(t *testing.T, s *Server, req *pb.StartRequest) *Experiment {
+	t.Helper()
+
+	req.ExperimentId = "exp"
+	req.Target = proto.Int32(50)
+	if _, err := s.StartExperiment(context.Background(), req); err != nil {
+		t.Fatalf("StartExperiment() error = %v", err)
+	}
+	return s.experiments["exp"]
+}
+
+func TestResultsAfterUsernameTakeover(t *testing.T) {
+	tests := []struct {
+		name  string
+		stale bool // The previous client is removed as stale before its username is taken
+	}{
+		{name: "previous client kept", stale: false},
+		{name: "previous client removed", stale: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, store := newTestServer()
+			harry := join(t, s, "exp", "harry")
+			join(t, s, "exp", "ron")
+			exp := start(t, s, &pb.StartRequest{ResponseMode: pb.ResponseMode_AUTOMATIC})
+
+			s.processGuess(exp, "harry", 10)
+			leave(s, harry)
+			if tt.stale {
+				delete(exp.clients, "harry")
+			}
+
+			// Someone else takes the username and guesses the number
+			join(t, s, "exp", "harry")
+			s.processGuess(exp, "harry", 20)
+			s.processGuess(exp, "harry", 50)
+
+			resp, err := s.EndExperiment(context.Background(), &pb.EndRequest{ExperimentId: "exp"})
+			if err != nil {
+				t.Fatalf("EndExperiment() error = %v", err)
+			}
+
+			results := resp.Result.Results
+			if len(results) != 2 {
+				t.Fatalf("got %d results, want 2: %v", len(results), results)
+			}
+			if r := results[0]; r.Username != "harry" || !r.Won || r.Guesses != 2 || r.Rank != 1 {
+				t.Errorf("first result = %v, want harry won in 2 guesses", r)
+			}
+			if r := results[1]; r.Username != "ron" || r.Won || r.Rank != 2 {
+				t.Errorf("second result = %v, want ron who did not win", r)
+			}
+
+			leaderboard, err := store.Leaderboard("exp")
+			if err != nil {
+				t.Fatal(err)
+			}
+			plays := map[string]int{}
+			for _, stats := range leaderboard {
+				plays[stats.Username] = stats.Experiments
+			}
+			// Ron made no guesses, so the run is not counted as played
+			if plays["harry"] != 1 || plays["ron"] != 0 {
+				t.Errorf("plays = %v, want harry 1 and ron 0", plays)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Shutdown ends the running experiments, so that their results are sent and saved, and closes the streams
+// of the clients once they receive the queued messages. New clients and experiments are rejected afterwards
+func (s *Server) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopping = true
+	for _, exp := range s.experiments {
+		if exp.active {
+			log.Printf("Ending experiment '%s' before shutdown", exp.id)
+			s.endExperiment(exp)
+		}
+		for _, client := range exp.clients {
+			if client.connected {
+				client.outbox.push(shutdownMessage())
+				client.outbox.close(status.Error(codes.Unavailable, "server is shutting down"))
+			}
+		}
+	}
+
+	// Operators watching the events are disconnected too, otherwise their calls never finish
+	s.events.close()
+}
+
+// stopServers waits for the calls in progress to finish and cancels the remaining ones after timeout
+func stopServers(grpcServer *grpc.Server, httpServer *http.Server, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if httpServer != nil {
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Printf("Failed to stop HTTP server gracefully: %v", err)
+			httpServer.Close()
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Printf("Calls did not finish in %v, closing them", timeout)
+		grpcServer.Stop()
+	}
+}
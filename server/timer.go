@@ -0,0 +1,55 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+// Clients are reminded of the remaining time every minute and then when this much time is left
+var finalReminders = []time.Duration{30 * time.Second, 10 * time.Second}
+
+// nextReminder returns how much time will be left at the next time remaining notification,
+// or 0 if the next event is the deadline itself
+func nextReminder(remaining time.Duration) time.Duration {
+	if remaining > time.Minute {
+		// The previous full minute, e.g. 4m for 4m30s and 3m for 4m
+		return (remaining - 1) / time.Minute * time.Minute
+	}
+	for _, reminder := range finalReminders {
+		if remaining > reminder {
+			return reminder
+		}
+	}
+	return 0
+}
+
+// scheduleReminder schedules the next time remaining notification of the experiment, or its end
+// once the deadline is reached. Must be called with s.mu held
+func (s *Server) scheduleReminder(exp *Experiment) {
+	startedAt := exp.startedAt
+	reminder := nextReminder(time.Until(exp.deadline))
+	exp.timer = time.AfterFunc(time.Until(exp.deadline.Add(-reminder)), func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// The experiment may have been ended or restarted in the meantime
+		if !exp.active || !exp.startedAt.Equal(startedAt) {
+			return
+		}
+
+		if reminder == 0 {
+			log.Printf("Experiment '%s' reached its deadline", exp.id)
+			s.endExperiment(exp)
+			return
+		}
+
+		// Disconnected clients learn the deadline from the start message when they resume
+		message := timeRemainingMessage(exp, reminder)
+		for _, client := range exp.clients {
+			if client.connected {
+				s.send(client, message)
+			}
+		}
+		s.scheduleReminder(exp)
+	})
+}
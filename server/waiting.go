@@ -0,0 +1,87 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	pb "github.com/Kamil-Jan/hogwarts_experiment/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WaitingList returns the guesses of the given experiment, or of all experiments, awaiting a response, the oldest
+// first, so that the clients can be answered in the order they have sent the guesses
+func (s *Server) WaitingList(ctx context.Context, req *pb.WaitingListRequest) (*pb.WaitingListResponse, error) {
+	var minWait time.Duration
+	if req.MinWait != nil {
+		if err := req.MinWait.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min wait: %v", err)
+		}
+		minWait = req.MinWait.AsDuration()
+		if minWait < 0 {
+			return nil, status.Error(codes.InvalidArgument, "min wait cannot be negative")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	experiments := s.experiments
+	if req.ExperimentId != "" {
+		exp, err := s.getExperiment(req.ExperimentId)
+		if err != nil {
+			return nil, err
+		}
+		experiments = map[string]*Experiment{exp.id: exp}
+	}
+
+	now := time.Now()
+	resp := &pb.WaitingListResponse{Usernames: []string{}, Entries: []*pb.WaitingEntry{}}
+	for _, exp := range experiments {
+		for username, queue := range exp.pendingResponses {
+			for _, guess := range queue {
+				if now.Sub(guess.receivedAt) < minWait {
+					continue
+				}
+				resp.Entries = append(resp.Entries, waitingEntry(exp, username, guess, now))
+			}
+		}
+	}
+	slices.SortFunc(resp.Entries, compareWaitingEntries)
+
+	// Every client is listed once, at the position of its oldest guess
+	for _, entry := range resp.Entries {
+		if !slices.Contains(resp.Usernames, entry.Username) {
+			resp.Usernames = append(resp.Usernames, entry.Username)
+		}
+	}
+	return resp, nil
+}
+
+// waitingEntry describes the pending guess of the client of the experiment
+func waitingEntry(exp *Experiment, username string, guess pendingGuess, now time.Time) *pb.WaitingEntry {
+	entry := &pb.WaitingEntry{
+		ExperimentId: exp.id,
+		Username:     username,
+		GuessId:      guess.id,
+		Guess:        guess.number,
+		ReceivedAt:   timestamppb.New(guess.receivedAt),
+		Wait:         durationpb.New(now.Sub(guess.receivedAt)),
+	}
+	if guess.claim(now) != "" {
+		entry.ClaimedUntil = timestamppb.New(guess.claimedUntil)
+	}
+	return entry
+}
+
+// compareWaitingEntries orders the guesses by the time they were received
+func compareWaitingEntries(a, b *pb.WaitingEntry) int {
+	if c := a.ReceivedAt.AsTime().Compare(b.ReceivedAt.AsTime()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.GuessId, b.GuessId)
+}
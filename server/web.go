@@ -0,0 +1,20 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// Static files of the web frontend, built into the binary
+//
+//go:embed web
+var webFS embed.FS
+
+// webFiles returns the frontend files with the "web" prefix stripped
+func webFiles() fs.FS {
+	files, err := fs.Sub(webFS, "web")
+	if err != nil {
+		panic(err)
+	}
+	return files
+}
@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+)
+
+const (
+	eventParticipant = "participant"
+	eventPlay        = "play"
+	eventWin         = "win"
+	eventExperiment  = "experiment"
+	eventGuess       = "guess"
+	eventResponse    = "response"
+)
+
+// event is a single line of the append-only log
+type event struct {
+	Type         string            `json:"type"`
+	ExperimentID string            `json:"experiment_id,omitempty"`
+	Username     string            `json:"username,omitempty"`
+	Guesses      int               `json:"guesses,omitempty"` // Guesses needed to win, for the win events
+	Experiment   *ExperimentRecord `json:"experiment,omitempty"`
+	Guess        *GuessRecord      `json:"guess,omitempty"`
+	Response     *ResponseRecord   `json:"response,omitempty"`
+}
+
+// FileStore keeps the data in memory and appends every change to a JSON Lines log,
+// which is replayed when the store is opened
+type FileStore struct {
+	*MemoryStore
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenFileStore opens the log at path, creating it if needed, and restores the data from it
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store file: %w", err)
+	}
+
+	store := &FileStore{MemoryStore: NewMemoryStore(), file: file}
+	if err := store.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// replay applies all the events from the log to the in-memory state.
+// An incomplete last line, left by a write interrupted by a crash, is cut off the log
+func (f *FileStore) replay() error {
+	reader := bufio.NewReader(f.file)
+	var offset int64
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(data) == 0 {
+				return nil
+			}
+			return f.replayLastLine(data, offset, line)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read store file: %w", err)
+		}
+
+		var e event
+		if err := json.Unmarshal(data, &e); err != nil {
+			return fmt.Errorf("failed to parse store file at line %d: %w", line, err)
+		}
+		if err := f.apply(e); err != nil {
+			return fmt.Errorf("failed to apply store file line %d: %w", line, err)
+		}
+		offset += int64(len(data))
+	}
+}
+
+// replayLastLine applies the last line of the log, which has no newline at the end.
+// If the line cannot be parsed, the write was interrupted and the line is truncated
+func (f *FileStore) replayLastLine(data []byte, offset int64, line int) error {
+	var e event
+	if err := json.Unmarshal(data, &e); err != nil {
+		log.Printf("Truncating incomplete store file line %d: %v", line, err)
+		if err := f.file.Truncate(offset); err != nil {
+			return fmt.Errorf("failed to truncate store file: %w", err)
+		}
+		return nil
+	}
+	if err := f.apply(e); err != nil {
+		return fmt.Errorf("failed to apply store file line %d: %w", line, err)
+	}
+	// Terminate the line, so that the next event is written on its own line
+	if _, err := f.file.Write([]byte{'\n'}); err != nil {
+		return fmt.Errorf("failed to write store file: %w", err)
+	}
+	return nil
+}
+
+func (f *FileStore) apply(e event) error {
+	switch e.Type {
+	case eventParticipant:
+		return f.MemoryStore.AddParticipant(e.ExperimentID, e.Username)
+	case eventPlay:
+		return f.MemoryStore.AddPlay(e.ExperimentID, e.Username)
+	case eventWin:
+		return f.MemoryStore.AddWin(e.ExperimentID, e.Username, e.Guesses)
+	case eventExperiment:
+		if e.Experiment == nil {
+			return fmt.Errorf("experiment event without experiment")
+		}
+		return f.MemoryStore.SaveExperiment(*e.Experiment)
+	case eventGuess:
+		if e.Guess == nil {
+			return fmt.Errorf("guess event without guess")
+		}
+		return f.MemoryStore.SaveGuess(*e.Guess)
+	case eventResponse:
+		if e.Response == nil {
+			return fmt.Errorf("response event without response")
+		}
+		return f.MemoryStore.SaveResponse(*e.Response)
+	default:
+		return fmt.Errorf("unknown event type '%s'", e.Type)
+	}
+}
+
+// append writes the event to the log and then applies it to the in-memory state
+func (f *FileStore) append(e event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write store file: %w", err)
+	}
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync store file: %w", err)
+	}
+	return f.apply(e)
+}
+
+func (f *FileStore) AddParticipant(experimentID, username string) error {
+	if f.MemoryStore.hasParticipant(experimentID, username) {
+		return nil
+	}
+	return f.append(event{Type: eventParticipant, ExperimentID: experimentID, Username: username})
+}
+
+func (f *FileStore) AddPlay(experimentID, username string) error {
+	return f.append(event{Type: eventPlay, ExperimentID: experimentID, Username: username})
+}
+
+func (f *FileStore) AddWin(experimentID, username string, guesses int) error {
+	return f.append(event{Type: eventWin, ExperimentID: experimentID, Username: username, Guesses: guesses})
+}
+
+func (f *FileStore) SaveExperiment(rec ExperimentRecord) error {
+	return f.append(event{Type: eventExperiment, Experiment: &rec})
+}
+
+func (f *FileStore) SaveGuess(rec GuessRecord) error {
+	return f.append(event{Type: eventGuess, Guess: &rec})
+}
+
+func (f *FileStore) SaveResponse(rec ResponseRecord) error {
+	// Check first, a response to an unknown guess would break the replay of the log
+	if !f.MemoryStore.hasGuess(rec.ExperimentID, rec.GuessID) {
+		return fmt.Errorf("guess '%s' not found in experiment '%s'", rec.GuessID, rec.ExperimentID)
+	}
+	return f.append(event{Type: eventResponse, Response: &rec})
+}
+
+func (f *FileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fill makes the changes to the store that the replay of its log has to restore
+func fill(t *testing.T, store Store) {
+	t.Helper()
+
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	steps := []func() error{
+		func() error { return store.AddParticipant("exp", "harry") },
+		func() error { return store.AddParticipant("exp", "ron") },
+		func() error {
+			return store.SaveGuess(GuessRecord{ID: "g1", ExperimentID: "exp", Username: "harry", Number: 50, Time: now})
+		},
+		func() error {
+			return store.SaveResponse(ResponseRecord{GuessID: "g1", ExperimentID: "exp", Verdict: "LOWER", Time: now.Add(time.Second)})
+		},
+		func() error {
+			return store.SaveGuess(GuessRecord{ID: "g2", ExperimentID: "exp", Username: "harry", Number: 25, Time: now.Add(2 * time.Second)})
+		},
+		func() error {
+			return store.SaveResponse(ResponseRecord{
+				GuessID: "g2", ExperimentID: "exp", Verdict: "CORRECT", Time: now.Add(3 * time.Second),
+				Overridden: true, TrueVerdict: "HIGHER", Message: "Yes!",
+			})
+		},
+		func() error { return store.AddWin("exp", "harry", 2) },
+		func() error { return store.AddPlay("exp", "harry") },
+		func() error {
+			return store.SaveExperiment(ExperimentRecord{ID: "exp", TargetNum: 25, StartedAt: now, EndedAt: now.Add(time.Minute)})
+		},
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+}
+
+// snapshot is everything a store returns, with the leaderboards sorted by username
+type snapshot struct {
+	Overall     []PlayerStats
+	Leaderboard []PlayerStats
+	Experiments []ExperimentRecord
+	Guesses     []GuessRecord
+}
+
+func takeSnapshot(t *testing.T, store Store) snapshot {
+	t.Helper()
+
+	var snap snapshot
+	var err error
+	if snap.Overall, err = store.Leaderboard(""); err != nil {
+		t.Fatal(err)
+	}
+	if snap.Leaderboard, err = store.Leaderboard("exp"); err != nil {
+		t.Fatal(err)
+	}
+	if snap.Experiments, err = store.Experiments(); err != nil {
+		t.Fatal(err)
+	}
+	if snap.Guesses, err = store.Guesses("exp"); err != nil {
+		t.Fatal(err)
+	}
+	for _, stats := range [][]PlayerStats{snap.Overall, snap.Leaderboard} {
+		sort.Slice(stats, func(i, j int) bool { return stats[i].Username < stats[j].Username })
+	}
+	return snap
+}
+
+func TestFileStoreReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fill(t, store)
+	want := takeSnapshot(t, store)
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if got := takeSnapshot(t, reopened); !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed store differs:\ngot  %+v\nwant %+v", got, want)
+	}
+
+	memory := NewMemoryStore()
+	fill(t, memory)
+	if got := takeSnapshot(t, memory); !reflect.DeepEqual(got, want) {
+		t.Errorf("file store differs from memory store:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestFileStoreReplayDamagedLog(t *testing.T) {
+	const (
+		participant = `{"type":"participant","experiment_id":"exp","username":"harry"}`
+		play        = `{"type":"play","experiment_id":"exp","username":"harry"}`
+	)
+
+	tests := []struct {
+		name        string
+		log         string
+		wantErr     string
+		wantPlays   int    // Plays of harry after the replay
+		wantLog     string // Content of the log after the replay
+		wantReplays int    // Plays of harry after an event is appended and the log is replayed again
+	}{
+		{
+			name:        "complete log",
+			log:         participant + "\n" + play + "\n",
+			wantPlays:   1,
+			wantLog:     participant + "\n" + play + "\n",
+			wantReplays: 2,
+		},
+		{
+			name:        "torn last line",
+			log:         participant + "\n" + play[:20],
+			wantPlays:   0,
+			wantLog:     participant + "\n",
+			wantReplays: 1,
+		},
+		{
+			name:        "last line without newline",
+			log:         participant + "\n" + play,
+			wantPlays:   1,
+			wantLog:     participant + "\n" + play + "\n",
+			wantReplays: 2,
+		},
+		{
+			name:    "corrupted line in the middle",
+			log:     participant + "\n" + play[:20] + "\n" + play + "\n",
+			wantErr: "line 2",
+		},
+		{
+			name:    "unknown event",
+			log:     `{"type":"spell"}` + "\n",
+			wantErr: "unknown event type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "store.jsonl")
+			if err := os.WriteFile(path, []byte(tt.log), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			store, err := OpenFileStore(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("OpenFileStore() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OpenFileStore() error = %v", err)
+			}
+			if got := plays(t, store); got != tt.wantPlays {
+				t.Errorf("plays after replay = %d, want %d", got, tt.wantPlays)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.wantLog {
+				t.Errorf("log after replay = %q, want %q", data, tt.wantLog)
+			}
+
+			// The events appended after the replay must be readable
+			if err := store.AddPlay("exp", "harry"); err != nil {
+				t.Fatal(err)
+			}
+			store.Close()
+			store, err = OpenFileStore(path)
+			if err != nil {
+				t.Fatalf("OpenFileStore() after append error = %v", err)
+			}
+			defer store.Close()
+			if got := plays(t, store); got != tt.wantReplays {
+				t.Errorf("plays after second replay = %d, want %d", got, tt.wantReplays)
+			}
+		})
+	}
+}
+
+// plays returns the number of runs of the experiment harry has played
+func plays(t *testing.T, store Store) int {
+	t.Helper()
+
+	leaderboard, err := store.Leaderboard("exp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stats := range leaderboard {
+		if stats.Username == "harry" {
+			return stats.Experiments
+		}
+	}
+	return 0
+}
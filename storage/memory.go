@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MemoryStore keeps everything in memory. The data is lost when the process exits
+type MemoryStore struct {
+	mu          sync.Mutex
+	leaderboard map[string]*PlayerStats            // Overall stats by username
+	experiments map[string]map[string]*PlayerStats // Stats by username for each experiment
+	history     []ExperimentRecord
+	guesses     []GuessRecord
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		leaderboard: make(map[string]*PlayerStats),
+		experiments: make(map[string]map[string]*PlayerStats),
+	}
+}
+
+// hasParticipant reports whether the user already has a leaderboard entry in the experiment
+func (m *MemoryStore) hasParticipant(experimentID, username string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.experiments[experimentID][username]
+	return ok
+}
+
+// stats returns the experiment and overall stats of the user, creating them if needed.
+// Must be called with m.mu held
+func (m *MemoryStore) stats(experimentID, username string) (*PlayerStats, *PlayerStats) {
+	players, ok := m.experiments[experimentID]
+	if !ok {
+		players = make(map[string]*PlayerStats)
+		m.experiments[experimentID] = players
+	}
+	overall, ok := m.leaderboard[username]
+	if !ok {
+		overall = &PlayerStats{Username: username}
+		m.leaderboard[username] = overall
+	}
+	stats, ok := players[username]
+	if !ok {
+		stats = &PlayerStats{Username: username}
+		players[username] = stats
+	}
+	return stats, overall
+}
+
+func (m *MemoryStore) AddParticipant(experimentID, username string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stats(experimentID, username)
+	return nil
+}
+
+func (m *MemoryStore) AddPlay(experimentID, username string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, overall := m.stats(experimentID, username)
+	stats.Experiments++
+	overall.Experiments++
+	return nil
+}
+
+func (m *MemoryStore) AddWin(experimentID, username string, guesses int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, overall := m.stats(experimentID, username)
+	for _, p := range []*PlayerStats{stats, overall} {
+		p.Wins++
+		p.GuessesToWin += guesses
+		if p.BestGuesses == 0 || guesses < p.BestGuesses {
+			p.BestGuesses = guesses
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) Leaderboard(experimentID string) ([]PlayerStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	players := m.leaderboard
+	if experimentID != "" {
+		players = m.experiments[experimentID]
+	}
+
+	// Return copies so the caller can use them without holding the lock
+	leaderboard := make([]PlayerStats, 0, len(players))
+	for _, stats := range players {
+		leaderboard = append(leaderboard, *stats)
+	}
+	return leaderboard, nil
+}
+
+func (m *MemoryStore) SaveExperiment(rec ExperimentRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.history = append(m.history, rec)
+	return nil
+}
+
+func (m *MemoryStore) Experiments() ([]ExperimentRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]ExperimentRecord(nil), m.history...), nil
+}
+
+func (m *MemoryStore) SaveGuess(rec GuessRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.guesses = append(m.guesses, rec)
+	stats, overall := m.stats(rec.ExperimentID, rec.Username)
+	stats.Guesses++
+	overall.Guesses++
+	return nil
+}
+
+// findGuess returns the index of the guess, or -1 if there is none. Must be called with m.mu held
+func (m *MemoryStore) findGuess(experimentID, id string) int {
+	// Responses usually answer one of the latest guesses, so search from the end
+	for i := len(m.guesses) - 1; i >= 0; i-- {
+		if m.guesses[i].ID == id && m.guesses[i].ExperimentID == experimentID {
+			return i
+		}
+	}
+	return -1
+}
+
+// hasGuess reports whether the guess has been saved
+func (m *MemoryStore) hasGuess(experimentID, id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.findGuess(experimentID, id) >= 0
+}
+
+func (m *MemoryStore) SaveResponse(rec ResponseRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.findGuess(rec.ExperimentID, rec.GuessID)
+	if i < 0 {
+		return fmt.Errorf("guess '%s' not found in experiment '%s'", rec.GuessID, rec.ExperimentID)
+	}
+	m.guesses[i].Response = &rec
+	return nil
+}
+
+func (m *MemoryStore) Guesses(experimentID string) ([]GuessRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	guesses := []GuessRecord{}
+	for _, rec := range m.guesses {
+		if rec.ExperimentID == experimentID {
+			if rec.Response != nil {
+				response := *rec.Response
+				rec.Response = &response
+			}
+			guesses = append(guesses, rec)
+		}
+	}
+	return guesses, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,77 @@
+package storage
+
+import "time"
+
+// ExperimentRecord describes a finished experiment
+type ExperimentRecord struct {
+	ID        string    `json:"id"`
+	TargetNum int       `json:"target_num"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+}
+
+// GuessRecord describes a single guess made by a participant
+type GuessRecord struct {
+	ID           string          `json:"id,omitempty"`
+	ExperimentID string          `json:"experiment_id"`
+	Username     string          `json:"username"`
+	Number       int32           `json:"number"`
+	Time         time.Time       `json:"time"`
+	Response     *ResponseRecord `json:"response,omitempty"` // Set once the guess is answered
+}
+
+// ResponseRecord describes the response given to a guess
+type ResponseRecord struct {
+	GuessID      string    `json:"guess_id"`
+	ExperimentID string    `json:"experiment_id"`
+	Verdict      string    `json:"verdict"` // Verdict sent to the participant
+	Time         time.Time `json:"time"`
+
+	// Set if the operator replaced the true response
+	Overridden  bool   `json:"overridden,omitempty"`
+	TrueVerdict string `json:"true_verdict,omitempty"` // Verdict computed from the target number
+	Message     string `json:"message,omitempty"`      // Text sent instead of the default one
+}
+
+// PlayerStats summarizes the results of a participant in an experiment or in all of them
+type PlayerStats struct {
+	Username     string
+	Wins         int
+	Experiments  int // Number of runs of the experiments the user made guesses in
+	Guesses      int // Total number of guesses made
+	GuessesToWin int // Total number of guesses made in the won experiments
+	BestGuesses  int // Fewest guesses needed to win, 0 if the user has never won
+}
+
+// AverageGuessesToWin returns the average number of guesses the user needed to win, 0 if the user has never won
+func (p PlayerStats) AverageGuessesToWin() float64 {
+	if p.Wins == 0 {
+		return 0
+	}
+	return float64(p.GuessesToWin) / float64(p.Wins)
+}
+
+// Store persists the leaderboard, the experiment history and the guesses
+type Store interface {
+	// AddParticipant makes sure the user has an entry in the experiment and overall leaderboards
+	AddParticipant(experimentID, username string) error
+	// AddPlay records that the user made guesses in a finished run of the experiment
+	AddPlay(experimentID, username string) error
+	// AddWin records a win of the user, who needed the given number of guesses, in the experiment and overall leaderboards
+	AddWin(experimentID, username string, guesses int) error
+	// Leaderboard returns the stats of the participants of the experiment, or the overall ones if experimentID is empty.
+	// The order of the entries is not defined
+	Leaderboard(experimentID string) ([]PlayerStats, error)
+
+	SaveExperiment(rec ExperimentRecord) error
+	Experiments() ([]ExperimentRecord, error)
+
+	// SaveGuess records the guess and counts it in the stats of the user
+	SaveGuess(rec GuessRecord) error
+	// SaveResponse attaches the response to the guess it answers
+	SaveResponse(rec ResponseRecord) error
+	// Guesses returns the guesses made in the experiment, with their responses, in the order they were saved
+	Guesses(experimentID string) ([]GuessRecord, error)
+
+	Close() error
+}